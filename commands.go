@@ -0,0 +1,715 @@
+// ============================================================================
+// = commands.go
+// = 	Description		Subcommands that work on the set of sequences, not one
+// = 	Note			Run as: go run . list -keyword hard, go run . search stirling,
+// = 					go run . lookup 1,1,2,5,14,42, go run . guess A000045
+// = 	Date			2026.10.17
+// ============================================================================
+
+package main
+
+import (
+	"OEIS/seq"
+	"OEIS/utils"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode"
+)
+
+// every subcommand, keyed by the name given as the first argument
+var commands = map[string]func(args []string) error{
+	"list":   listCommand,
+	"lookup": lookupCommand,
+	"search": searchCommand,
+	"guess":  guessCommand,
+	"verify": verifyCommand,
+}
+
+// lists the implemented sequences, optionally filtered by ID range, keyword,
+// term type and speed class
+func listCommand(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	from := fs.String("from", "", "The first ID to list, e.g. A000100")
+	to := fs.String("to", "", "The last ID to list, e.g. A000199")
+	keyword := fs.String("keyword", "", "Only list sequences with all of these OEIS keywords, comma-separated (e.g. hard,more)")
+	kind := fs.String("type", "", "Only list sequences computed with this type: int64 or big.Int")
+	speed := fs.String("speed", "", "Only list sequences of this speed class: fast, moderate or slow")
+	missing := fs.Bool("missing", false, "List the sequences of -stripped that are not implemented yet, instead")
+	stripped := fs.String("stripped", "", "The copy of the OEIS stripped.gz to list the missing sequences of")
+	names := fs.String("names", "", "The copy of the OEIS names.gz to name the missing sequences with. Defaults to names.gz next to -stripped")
+	fs.Parse(args)
+
+	first, last := "A000000", "A999999"
+	var err error
+	if *from != "" {
+		if first, err = parseID(*from); err != nil {
+			return err
+		}
+	}
+	if *to != "" {
+		if last, err = parseID(*to); err != nil {
+			return err
+		}
+	}
+	if *missing {
+		if *stripped == "" {
+			return errors.New("-missing needs the OEIS stripped.gz, given with -stripped")
+		}
+		if *keyword != "" || *kind != "" || *speed != "" {
+			return errors.New("-missing can only be combined with -from and -to")
+		}
+		return listMissing(first, last, *stripped, *names)
+	}
+
+	keywords := make([]string, 0)
+	if *keyword != "" {
+		keywords = strings.Split(*keyword, ",")
+	}
+	for _, k := range keywords {
+		if !contains(seq.Keywords, k) {
+			return errors.New("unknown keyword " + strconv.Quote(k) + "; use one of " + strings.Join(seq.Keywords, ", "))
+		}
+	}
+	if *kind != "" && *kind != seq.IntKind.String() && *kind != seq.BigKind.String() {
+		return errors.New("unknown -type " + strconv.Quote(*kind) + "; use int64 or big.Int")
+	}
+	if *speed != "" && *speed != seq.Fast.String() && *speed != seq.Moderate.String() && *speed != seq.Slow.String() {
+		return errors.New("unknown -speed " + strconv.Quote(*speed) + "; use fast, moderate or slow")
+	}
+
+	found := make([]seq.Sequence, 0)
+	for _, s := range seq.All() {
+		m := s.Meta()
+		match := m.ID >= first && m.ID <= last
+		match = match && (*kind == "" || m.Kind.String() == *kind)
+		match = match && (*speed == "" || m.Speed.String() == *speed)
+		for _, k := range keywords {
+			match = match && m.HasKeyword(k)
+		}
+		if match {
+			found = append(found, s)
+		}
+	}
+	return printList(os.Stdout, found)
+}
+
+// lists the sequences of the OEIS dump from first through last that are not
+// implemented yet
+func listMissing(first, last, stripped, names string) error {
+	d, err := openDump(stripped, names)
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, id := range d.IDs() {
+		if _, ok := seq.Lookup(id); ok || id < first || id > last {
+			continue
+		}
+		count++
+		fmt.Fprintf(tw, "%s\t%s\n", id, d.Name(id))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	utils.PrintInfo(strconv.Itoa(count) + " sequences are not implemented yet")
+	return nil
+}
+
+// lists the sequences whose name contains every word of the arguments
+func searchCommand(args []string) error {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: search <text>, e.g. search stirling numbers")
+	}
+	fs.Parse(args)
+
+	query := strings.Join(fs.Args(), " ")
+	if strings.TrimSpace(query) == "" {
+		return errors.New("you need to give some text to search for! ")
+	}
+	return printList(os.Stdout, seq.Search(query))
+}
+
+// lists the sequences whose terms contain the given terms, e.g. lookup 1,1,2,5,14,42
+func lookupCommand(args []string) error {
+	fs := flag.NewFlagSet("lookup", flag.ExitOnError)
+	seqlen := fs.Int64("seqlen", 40, "How many terms of each sequence to search")
+	timeout := fs.Duration("timeout", 2*time.Second, "How long each sequence may take to compute its terms")
+	stripped := fs.String("stripped", "", "Search this copy of the OEIS stripped.gz instead of computing the implemented sequences")
+	names := fs.String("names", "", "The copy of the OEIS names.gz to name the matches with. Defaults to names.gz next to -stripped")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: lookup [flags] <terms>, e.g. lookup 1,1,2,5,14,42")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	fields := strings.FieldsFunc(strings.Join(fs.Args(), " "), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	if len(fields) == 0 {
+		return errors.New("you need to give some terms to look up! ")
+	}
+	query := make([]*big.Int, len(fields))
+	for i, f := range fields {
+		v, ok := new(big.Int).SetString(f, 10)
+		if !ok {
+			return errors.New("invalid term " + strconv.Quote(f))
+		}
+		query[i] = v
+	}
+	if *stripped != "" {
+		return lookupOffline(query, *stripped, *names)
+	}
+	if *seqlen < int64(len(query)) {
+		return errors.New("-seqlen must be at least the number of terms to look up")
+	}
+
+	matches, late := seq.Find(context.Background(), query, *seqlen, *timeout)
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, m := range matches {
+		last := m.N + int64(len(query)) - 1
+		fmt.Fprintf(tw, "%s\ta(%d..%d)\t%s\n", m.Seq.ID(), m.N, last, m.Seq.Meta().Name)
+		fmt.Fprintf(tw, "\t\t%s\n", alignTerms(m.Terms, int(m.N-m.Seq.Offset()), len(query)))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if len(matches) == 1 {
+		utils.PrintInfo("1 sequence matches")
+	} else {
+		utils.PrintInfo(strconv.Itoa(len(matches)) + " sequences match")
+	}
+	if len(late) > 0 {
+		utils.FprintWarning(os.Stderr, "Ran out of time computing "+strconv.Itoa(len(late))+" sequences; only the terms found within "+timeout.String()+" were searched: "+strings.Join(late, ", "))
+	}
+	return nil
+}
+
+// lists the sequences of the OEIS dump whose terms contain query, marking the
+// ones that are implemented here
+func lookupOffline(query []*big.Int, stripped, names string) error {
+	d, err := openDump(stripped, names)
+	if err != nil {
+		return err
+	}
+
+	matches := d.Find(query)
+	implemented := 0
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, m := range matches {
+		status := "-"
+		if _, ok := seq.Lookup(m.ID); ok {
+			status = "implemented"
+			implemented++
+		}
+		terms, _ := d.Terms(m.ID)
+		fmt.Fprintf(tw, "%s\tterms %d..%d\t%s\t%s\n", m.ID, m.Index+1, m.Index+len(query), status, d.Name(m.ID))
+		fmt.Fprintf(tw, "\t\t\t%s\n", alignTerms(terms, m.Index, len(query)))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	utils.PrintInfo(strconv.Itoa(len(matches)) + " of " + strconv.Itoa(len(d.IDs())) + " OEIS sequences match (" + strconv.Itoa(implemented) + " implemented)")
+	return nil
+}
+
+// reads the OEIS dump files. names defaults to names.gz next to stripped,
+// if there is one.
+func openDump(stripped, names string) (*utils.Dump, error) {
+	if names == "" {
+		path := filepath.Join(filepath.Dir(stripped), "names.gz")
+		if _, err := os.Stat(path); err == nil {
+			names = path
+		}
+	}
+	return utils.OpenDump(stripped, names)
+}
+
+// formats the terms around a match, with the count terms starting at a[i] in
+// brackets: ..., 0, [1, 1, 2], 3, 5, ...
+func alignTerms(a []*big.Int, i, count int) string {
+	const context = 3 // terms shown on either side of the match
+	start, end := i-context, i+count+context
+	if start < 0 {
+		start = 0
+	}
+	if end > len(a) {
+		end = len(a)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("..., ")
+	}
+	for j := start; j < end; j++ {
+		if j > start {
+			b.WriteString(", ")
+		}
+		if j == i {
+			b.WriteString("[")
+		}
+		b.WriteString(a[j].String())
+		if j == i+count-1 {
+			b.WriteString("]")
+		}
+	}
+	if end < len(a) {
+		b.WriteString(", ...")
+	}
+	return b.String()
+}
+
+// verifies the implemented sequences against the OEIS stripped file or
+// b-files, printing the ones that don't pass and a summary
+func verifyCommand(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	stripped := fs.String("stripped", "", "The copy of the OEIS stripped.gz to compare the terms with")
+	bfiles := fs.String("bfiles", "", "A directory of b-files (b000045.txt or A000045.txt) to compare the terms with. These take precedence over -stripped")
+	seqlen := fs.Int64("seqlen", 40, "How many terms of each sequence to compare")
+	timeout := fs.Duration("timeout", 5*time.Second, "How long each sequence may take to compute its terms")
+	all := fs.Bool("all", false, "Print every sequence, not only the ones that fail, error or run out of time")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: verify [flags] [ids], e.g. verify -stripped stripped.gz A000045 A000108")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if *stripped == "" && *bfiles == "" {
+		return errors.New("verify needs reference terms, given with -stripped or -bfiles")
+	}
+
+	// the sequences to verify: the ones given, or all of them
+	seqs := seq.All()
+	if fs.NArg() > 0 {
+		seqs = make([]seq.Sequence, 0, fs.NArg())
+		for _, arg := range fs.Args() {
+			id, err := parseID(arg)
+			if err != nil {
+				return err
+			}
+			s, ok := seq.Lookup(id)
+			if !ok {
+				return errors.New(id + " is not implemented")
+			}
+			seqs = append(seqs, s)
+		}
+	}
+
+	d := utils.NewDump()
+	if *stripped != "" {
+		var err error
+		if d, err = utils.OpenDump(*stripped, ""); err != nil {
+			return err
+		}
+	}
+	// a b-file that can't be read is reported with its sequence
+	ref := func(id string) seq.Reference {
+		if *bfiles != "" {
+			for _, name := range []string{"b" + id[1:] + ".txt", id + ".txt"} {
+				f, err := os.Open(filepath.Join(*bfiles, name))
+				if err != nil {
+					continue
+				}
+				defer f.Close()
+				a, offset, err := utils.ReadBFile(f)
+				if err != nil {
+					return seq.Reference{Err: errors.New(name + ": " + err.Error())}
+				}
+				return seq.Reference{Terms: a, Offset: offset, HasOffset: true}
+			}
+		}
+		a, _ := d.Terms(id)
+		return seq.Reference{Terms: a}
+	}
+
+	reports := seq.VerifyAll(context.Background(), seqs, ref, *seqlen, *timeout)
+
+	counts := map[seq.Status]int{}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, r := range reports {
+		counts[r.Status]++
+		if (r.Status != seq.Pass && r.Status != seq.NoData) || *all {
+			fmt.Fprintf(tw, "%s\t%s\t%d terms\t%s\n", r.Seq.ID(), r.Status, r.Checked, describeReport(r))
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	summary := make([]string, 0)
+	for _, st := range []seq.Status{seq.Pass, seq.Fail, seq.TooSlow, seq.Errored, seq.NoData} {
+		summary = append(summary, st.String()+" "+strconv.Itoa(counts[st]))
+	}
+	utils.PrintInfo("Verified " + strconv.Itoa(len(reports)) + " sequences: " + strings.Join(summary, ", "))
+	if failed := counts[seq.Fail] + counts[seq.Errored]; failed > 0 {
+		return errors.New(strconv.Itoa(failed) + " sequences disagree with the reference, or could not be computed or checked")
+	}
+	return nil
+}
+
+// explains a verification report in a few words
+func describeReport(r seq.Report) string {
+	details := make([]string, 0)
+	if r.Got != nil {
+		details = append(details, fmt.Sprintf("a(%d) = %v, want %v", r.N, r.Got, r.Want))
+	}
+	if r.Shift != 0 {
+		details = append(details, fmt.Sprintf("the terms agree if shifted by %d", r.Shift))
+	}
+	if r.Offset {
+		details = append(details, "the offset "+strconv.FormatInt(r.Seq.Offset(), 10)+" disagrees with the reference")
+	}
+	if r.Err != nil {
+		details = append(details, r.Err.Error())
+	}
+	return strings.Join(details, "; ")
+}
+
+// guesses linear recurrences and rational g.f.s for sequences, or holonomic
+// recurrences, from their first terms or a b-file. Without ids or a b-file,
+// every sequence is tried and the ones with a recurrence are listed.
+func guessCommand(args []string) error {
+	fs := flag.NewFlagSet("guess", flag.ExitOnError)
+	seqlen := fs.Int64("seqlen", 60, "How many terms of each sequence to guess from")
+	timeout := fs.Duration("timeout", 5*time.Second, "How long each sequence may take to compute its terms")
+	bfile := fs.String("bfile", "", "Guess from the terms of this b-file instead of a sequence")
+	min := fs.Int("min", 8, "How many terms past the ones that determine a recurrence must confirm it")
+	order := fs.Int("order", 3, "The largest order of holonomic recurrence to try, or 0 for none")
+	degree := fs.Int("degree", 3, "The largest degree of the polynomials of a holonomic recurrence")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: guess [flags] [ids], e.g. guess A000045, or guess -bfile b000045.txt")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if *bfile != "" {
+		f, err := os.Open(*bfile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		a, offset, err := utils.ReadBFile(f)
+		if err != nil {
+			return errors.New(*bfile + ": " + err.Error())
+		}
+		r := seq.GuessTerms(a, *order, *degree)
+		return printGuess(os.Stdout, filepath.Base(*bfile), offset, r, *min)
+	}
+
+	// the sequences given are described in full
+	if fs.NArg() > 0 {
+		for _, arg := range fs.Args() {
+			id, err := parseID(arg)
+			if err != nil {
+				return err
+			}
+			s, ok := seq.Lookup(id)
+			if !ok {
+				return errors.New(id + " is not implemented")
+			}
+			r := seq.GuessSequence(context.Background(), s, *seqlen, *timeout, *order, *degree)
+			if r.Err != nil {
+				return r.Err
+			}
+			if err := printGuess(os.Stdout, id, s.Offset(), r, *min); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// otherwise every sequence is tried, and the ones with a guess are listed
+	reports := seq.GuessAll(context.Background(), seq.All(), *seqlen, *timeout, *order, *degree)
+	count, holonomic := 0, 0
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, r := range reports {
+		switch {
+		case r.Found && r.Guess.Confirmed >= *min:
+			count++
+			fmt.Fprintf(tw, "%s\torder %d\t%d confirm\t%s\n", r.Seq.ID(), r.Guess.Order(), r.Guess.Confirmed,
+				formatRecurrence(r.Guess, r.Seq.Offset()))
+		case r.FoundHolonomic && r.Holonomic.Confirmed >= *min:
+			count++
+			holonomic++
+			h := r.Holonomic
+			fmt.Fprintf(tw, "%s\torder %d, degree %d\t%d confirm\t%s\n", r.Seq.ID(), h.Recurrence.Order(), h.Degree, h.Confirmed,
+				formatHolonomic(h.Recurrence, r.Seq.Offset()))
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	utils.PrintInfo("Guessed a recurrence for " + strconv.Itoa(count) + " of " + strconv.Itoa(len(reports)) +
+		" sequences, " + strconv.Itoa(holonomic) + " of them holonomic")
+	return nil
+}
+
+// prints the guess for the terms of one sequence, whose first term is
+// a(offset), or why there is none
+func printGuess(w io.Writer, name string, offset int64, r seq.GuessReport, min int) error {
+	if !r.Found && r.FoundHolonomic {
+		return printHolonomic(w, name, offset, r.Terms, r.Holonomic, min)
+	}
+	g, terms := r.Guess, r.Terms
+	if !r.Found {
+		_, err := fmt.Fprintf(w, "%s: no recurrence fits the %d terms\n", name, terms)
+		return err
+	}
+	if g.Confirmed < min {
+		_, err := fmt.Fprintf(w, "%s: the %d terms fit a recurrence of order %d, but only %d terms confirm it (-min is %d)\n",
+			name, terms, g.Order(), g.Confirmed, min)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s\torder %d, from %d terms, %d of which confirm it\n", name, g.Order(), terms, g.Confirmed)
+	fmt.Fprintf(tw, "recurrence\t%s\n", formatRecurrence(g, offset))
+	fmt.Fprintf(tw, "g.f.\t%s\n", formatGF(g, offset))
+	if r, ok := g.Recurrence(); ok {
+		fmt.Fprintf(tw, "declaration\tutils.LinearRecurrence{Coeffs: %s, Init: %s}\n", formatInts(r.Coeffs), formatInts(r.Init))
+	}
+	return tw.Flush()
+}
+
+// prints a holonomic guess like printGuess, with a declaration for it
+func printHolonomic(w io.Writer, name string, offset int64, terms int, g utils.HolonomicGuess, min int) error {
+	h := g.Recurrence
+	if g.Confirmed < min {
+		_, err := fmt.Fprintf(w, "%s: the %d terms fit a holonomic recurrence of order %d and degree %d, but only %d equations confirm it (-min is %d)\n",
+			name, terms, h.Order(), g.Degree, g.Confirmed, min)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s\tholonomic, order %d and degree %d, from %d terms, %d equations of which confirm it\n",
+		name, h.Order(), g.Degree, terms, g.Confirmed)
+	fmt.Fprintf(tw, "recurrence\t%s\n", formatHolonomic(h, offset))
+
+	// the declaration keeps n as the index from 0, like the code does
+	polys := make([]string, len(h.Polys))
+	for i, p := range h.Polys {
+		coeffs, ok := ratsToInts(p)
+		if !ok {
+			return tw.Flush()
+		}
+		polys[i] = strings.TrimPrefix(formatInts(coeffs), "[]int64")
+	}
+	init := make([]int64, len(h.Init))
+	for i, v := range h.Init {
+		if !v.IsInt64() {
+			return tw.Flush()
+		}
+		init[i] = v.Int64()
+	}
+	fmt.Fprintf(tw, "declaration\tutils.NewHolonomic([][]int64{%s}, %s)\n", strings.Join(polys, ", "), formatInts(init))
+	return tw.Flush()
+}
+
+// writes the recurrence of g as a(n) = c1*a(n-1) + ..., for the n it holds
+// for when the first term is a(offset)
+func formatRecurrence(g utils.Guess, offset int64) string {
+	terms := make([]*big.Rat, g.Order()+1)
+	for i, c := range g.Coeffs {
+		terms[i+1] = c
+	}
+	rhs := formatSum(terms, func(i int) string { return "a(n-" + strconv.Itoa(i) + ")" })
+	return "a(n) = " + rhs + " for n >= " + strconv.FormatInt(offset+int64(g.Order()), 10)
+}
+
+// writes the recurrence h as P0(n)*a(n) = P1(n)*a(n-1) + ..., for the n it
+// holds for when the first term is a(offset). The polynomials of h are in
+// the index from 0, so they are shifted to be in n.
+func formatHolonomic(h utils.Holonomic, offset int64) string {
+	term := func(p []*big.Rat, name string) string {
+		p = shiftPoly(p, offset)
+		if len(p) == 1 {
+			return formatSum(p, func(int) string { return name })
+		}
+		poly := formatPoly(p)
+		if strings.ContainsAny(poly[1:], "+-") {
+			poly = "(" + poly + ")"
+		}
+		return poly + "*" + name
+	}
+	rhs := ""
+	for i := 1; i <= h.Order(); i++ {
+		t := term(h.Polys[i], "a(n-"+strconv.Itoa(i)+")")
+		switch {
+		case t == "0":
+		case rhs == "":
+			rhs = t
+		case strings.HasPrefix(t, "-"):
+			rhs += " - " + t[1:]
+		default:
+			rhs += " + " + t
+		}
+	}
+	if rhs == "" {
+		rhs = "0"
+	}
+	return term(h.Polys[0], "a(n)") + " = " + rhs + " for n >= " + strconv.FormatInt(offset+int64(len(h.Init)), 10)
+}
+
+// returns q(n) = p(n - shift), by Horner's rule
+func shiftPoly(p []*big.Rat, shift int64) []*big.Rat {
+	q := []*big.Rat{new(big.Rat)}
+	s := new(big.Rat).SetInt64(-shift)
+	for j := len(p) - 1; j >= 0; j-- {
+		// q = q*(n - shift) + p[j]
+		next := make([]*big.Rat, len(q)+1)
+		next[0] = new(big.Rat)
+		for k, c := range q {
+			next[k+1] = new(big.Rat).Set(c)
+			next[k].Add(next[k], new(big.Rat).Mul(c, s))
+		}
+		next[0].Add(next[0], p[j])
+		q = next
+	}
+	for len(q) > 1 && q[len(q)-1].Sign() == 0 {
+		q = q[:len(q)-1]
+	}
+	return q
+}
+
+// writes the polynomial in n, from its highest power down
+func formatPoly(p []*big.Rat) string {
+	rev := make([]*big.Rat, len(p))
+	for i, c := range p {
+		rev[len(p)-1-i] = c
+	}
+	return formatSum(rev, func(i int) string {
+		switch e := len(p) - 1 - i; e {
+		case 0:
+			return ""
+		case 1:
+			return "n"
+		default:
+			return "n^" + strconv.Itoa(e)
+		}
+	})
+}
+
+// returns the coefficients as int64s, if they are all integers that fit
+func ratsToInts(c []*big.Rat) ([]int64, bool) {
+	ints := make([]int64, len(c))
+	for i, v := range c {
+		if !v.IsInt() || !v.Num().IsInt64() {
+			return nil, false
+		}
+		ints[i] = v.Num().Int64()
+	}
+	return ints, true
+}
+
+// writes the g.f. of g, which is x^offset Num(x)/Den(x)
+func formatGF(g utils.Guess, offset int64) string {
+	power := func(shift int64) func(i int) string {
+		return func(i int) string {
+			switch e := int64(i) + shift; e {
+			case 0:
+				return ""
+			case 1:
+				return "x"
+			default:
+				return "x^" + strconv.FormatInt(e, 10)
+			}
+		}
+	}
+	num := formatSum(g.Num, power(offset))
+	den := formatSum(g.Den, power(0))
+	if den == "1" {
+		return num
+	}
+	if strings.ContainsAny(num[1:], "+-") {
+		num = "(" + num + ")"
+	}
+	return num + "/(" + den + ")"
+}
+
+// writes Sum_i c[i] v(i), skipping the nil and zero coefficients, or 0
+func formatSum(c []*big.Rat, v func(i int) string) string {
+	var b strings.Builder
+	for i, ci := range c {
+		if ci == nil || ci.Sign() == 0 {
+			continue
+		}
+		coeff := new(big.Rat).Abs(ci).RatString()
+		switch {
+		case b.Len() == 0 && ci.Sign() < 0:
+			b.WriteString("-")
+		case b.Len() > 0 && ci.Sign() < 0:
+			b.WriteString(" - ")
+		case b.Len() > 0:
+			b.WriteString(" + ")
+		}
+		name := v(i)
+		switch {
+		case name == "":
+			b.WriteString(coeff)
+		case coeff == "1":
+			b.WriteString(name)
+		default:
+			b.WriteString(coeff + "*" + name)
+		}
+	}
+	if b.Len() == 0 {
+		return "0"
+	}
+	return b.String()
+}
+
+// writes the numbers as a Go slice literal
+func formatInts(a []int64) string {
+	parts := make([]string, len(a))
+	for i, v := range a {
+		parts[i] = strconv.FormatInt(v, 10)
+	}
+	return "[]int64{" + strings.Join(parts, ", ") + "}"
+}
+
+// prints one line per sequence (ID, type, speed class and name), then a count
+func printList(w io.Writer, seqs []seq.Sequence) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, s := range seqs {
+		m := s.Meta()
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", m.ID, m.Kind, m.Speed, m.Name)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if len(seqs) == 1 {
+		utils.PrintInfo("1 sequence")
+	} else {
+		utils.PrintInfo(strconv.Itoa(len(seqs)) + " sequences")
+	}
+	return nil
+}
+
+// parses an A-number, allowing lowercase and missing zeros: a45 is A000045
+func parseID(s string) (string, error) {
+	digits := strings.TrimPrefix(strings.ToUpper(s), "A")
+	n, err := strconv.Atoi(digits)
+	if err != nil || n < 0 || len(digits) > 6 {
+		return "", errors.New("invalid sequence id " + strconv.Quote(s))
+	}
+	return fmt.Sprintf("A%06d", n), nil
+}
+
+// reports whether list contains s
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
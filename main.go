@@ -8,327 +8,319 @@ package main
 
 import (
 	"OEIS/seq"
+	"OEIS/transform"
 	"OEIS/utils"
+	"context"
+	"encoding/json"
 	"errors"
 	"flag"
+	"fmt"
+	"io"
 	"math/big"
-	"reflect"
+	"os"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 )
 
 func main() {
+	// subcommands (list, search, ...) are given before any flags, see commands.go
+	if len(os.Args) > 1 {
+		if cmd, ok := commands[os.Args[1]]; ok {
+			handleError(cmd(os.Args[2:]))
+			return
+		}
+	}
+
 	// program initialization (flags)
 	seqid := flag.String("seq", "", "Which sequence to run. Example: -seq A000042")
 	seqlen := flag.Int64("seqlen", 5, "How many elements to generate. Most sequences will have restrictions on the # of elements to generate.")
 	comptime := flag.Bool("time", true, "True if you want approximate time-of-computation information printed. False otherwise")
+	n := flag.Int64("n", 0, "Compute only the single term a(n). Overrides -seqlen")
+	from := flag.Int64("from", 0, "The first index n to compute. Defaults to the sequence's offset")
+	to := flag.Int64("to", 0, "The last index n to compute. Defaults to -seqlen terms after -from")
+	timeout := flag.Duration("timeout", 0, "Stop after this long (e.g. 30s) and keep the terms found so far. Terms are printed as they are found")
+	format := flag.String("format", "table", "How to print the terms: "+strings.Join(utils.Formats(), ", "))
+	bfile := flag.String("bfile", "", "Write the terms to this path as an OEIS b-file instead of printing a table. Use - for stdout")
+	diag := flag.String("diag", "text", "How to report warnings from sequences, on stderr: text, json or none")
+	info := flag.String("info", "", "Print the name, keywords, offset, etc. of a sequence instead of computing it. Example: -info A000045")
+	transformName := flag.String("transform", "", "Apply a transform to the terms before printing them: "+strings.Join(transform.Names(), ", ")+". Example: -transform binomial")
+	internal := flag.String("internal", "", "Print a sequence as an entry in the OEIS internal format (%I %S %T %U %N %O %K), with as many terms as fit or as are found within -timeout (default 10s). Example: -internal A000045")
 
 	flag.Parse() // remember to parse!
 
-	_, exists := StubStorage[*seqid]
+	// -info describes a sequence without computing any terms
+	if *info != "" {
+		s, exists := seq.Lookup(strings.ToUpper(*info))
+		if !exists {
+			handleError(errors.New("either this sequence has not been implemented yet, or your id is invalid! "))
+		}
+		handleError(printMeta(os.Stdout, s.Meta()))
+		return
+	}
+
+	// -internal writes an entry to submit to the OEIS
+	if *internal != "" {
+		s, exists := seq.Lookup(strings.ToUpper(*internal))
+		if !exists {
+			handleError(errors.New("either this sequence has not been implemented yet, or your id is invalid! "))
+		}
+		limit := 10 * time.Second
+		if isFlagSet("timeout") {
+			limit = *timeout
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), limit)
+		defer cancel()
+		r, err := seq.NewRecord(ctx, s)
+		handleError(err)
+		handleError(seq.WriteInternal(os.Stdout, r))
+		return
+	}
+
+	id := strings.ToUpper(*seqid)
+	s, exists := seq.Lookup(id)
 
 	// check for invalid inputs
 	if *seqid == "" { // user must specify a sequence to generate
-		utils.HandleError(errors.New("you need to specify a sequence to generate! "))
+		handleError(errors.New("you need to specify a sequence to generate! "))
 	} else if *seqlen <= 0 { // check for invalid lengths
-		utils.HandleError(errors.New("you need to specify a positive sequence length! "))
-	} else if *seqlen < 5 {
-		utils.HandleError(errors.New("sequence length should be at least 5. "))
+		handleError(errors.New("you need to specify a positive sequence length! "))
 	} else if !exists { // user must specify a sequence that exists
-		utils.HandleError(errors.New("either this sequence has not been implemented yet, or your id is invalid! "))
+		handleError(errors.New("either this sequence has not been implemented yet, or your id is invalid! "))
 	}
 
-	// warn about long computation times if seqlen is greater than 500
-	if *seqlen >= 500 {
-		utils.PrintWarning("Depending on your system, this large of a sequence length will probably take a while to compute.")
+	// diagnostics are rendered as requested, and kept for the json output
+	render, err := diagnosticSink(*diag)
+	handleError(err)
+	diags := &utils.Collector{}
+	keep := diags.Sink()
+	ctx := utils.WithSink(context.Background(), func(d utils.Diagnostic) {
+		keep(d)
+		if render != nil {
+			render(d)
+		}
+	})
+
+	// the indices to compute: a single term, or seqlen terms starting at the offset
+	first := s.Offset()
+	if isFlagSet("from") {
+		first = *from
+	}
+	last := first + *seqlen - 1
+	if isFlagSet("to") {
+		last = *to
+	}
+	if isFlagSet("n") {
+		first, last = *n, *n
 	}
 
+	// transforms need every term from the offset on, all at once
+	var f transform.Func
+	if *transformName != "" {
+		if isFlagSet("n") || isFlagSet("from") || isFlagSet("timeout") {
+			handleError(errors.New("-transform applies to the terms from the offset on, so it can't be combined with -n, -from or -timeout"))
+		}
+		f, err = transform.Lookup(*transformName)
+		handleError(err)
+
+		// the divisor transforms start at a(1), so a(0) is dropped
+		if transform.FromOne(*transformName) {
+			switch s.Offset() {
+			case 0:
+				first, last = 1, last+1
+			case 1:
+			default:
+				handleError(errors.New("-transform " + *transformName + " needs the terms from a(1) on, but " + s.ID() + " starts at a(" + strconv.FormatInt(s.Offset(), 10) + ")"))
+			}
+		}
+	}
+
+	// warn about long computation times if more than 500 terms are requested
+	if last-first+1 >= 500 {
+		utils.Emit(ctx, utils.LongCalculationWarningWithLength(s.ID(), 500))
+	}
+
+	// -bfile is shorthand for -format bfile, written to a file
+	out := io.Writer(os.Stdout)
+	if *bfile != "" {
+		*format = "bfile"
+		if *bfile != "-" {
+			f, err := os.Create(*bfile)
+			handleError(err)
+			defer f.Close()
+			out = f
+		}
+	}
+	p, err := utils.NewPrinter(*format, out)
+	handleError(err)
+
+	header := utils.Header{Seq: s.ID(), Offset: s.Offset(), First: first}
+	if f != nil {
+		header.Seq, header.Offset = *transformName+"("+s.ID()+")", first
+	}
+	handleError(p.Begin(header))
 	start := time.Now()
-	temp, offset := handler(strings.ToUpper(*seqid), *seqlen)
+	count := 0
+	print := func(n int64, v *big.Int) error {
+		count++
+		return p.Term(n, v)
+	}
+	if f != nil {
+		err = computeTransformed(ctx, s, f, first, last, print)
+	} else if isFlagSet("timeout") {
+		err = streamRange(ctx, s, first, last, *timeout, print)
+	} else {
+		err = computeRange(ctx, s, first, last, print)
+	}
 	duration := time.Since(start)
+	handleError(err)
+	handleError(p.End(utils.Summary{Count: count, Duration: duration, Timed: *comptime, Diagnostics: diags.Diagnostics()}))
 
-	// convert & act accordingly
-	if reflect.TypeOf(temp).String() == "[]int64" {
-		utils.PrintSequence(*seqid, temp.([]int64), offset)
-	} else if reflect.TypeOf(temp).String() == "[]*big.Int" {
-		utils.PrintBigSequence(*seqid, temp.([]*big.Int), offset)
+	// the table reports the time itself; b-files written to a file don't
+	if *comptime && *bfile != "" && *bfile != "-" {
+		utils.PrintInfo("Wrote " + strconv.Itoa(count) + " terms of sequence " + s.ID() + " to " + *bfile + " in " + duration.String())
 	}
+}
+
+// computes a(first), ..., a(last) and prints them
+func computeRange(ctx context.Context, s seq.Sequence, first, last int64, print func(n int64, v *big.Int) error) error {
+	emitDiagnostics(ctx, s, last)
+	a, err := seq.Range(s, first, last)
+	if err != nil {
+		return err
+	}
+	for i, v := range a {
+		if err := print(first+int64(i), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	// output time if requested
-	if *comptime {
-		utils.PrintInfo("Computed " + strconv.FormatInt(*seqlen, 10) + " terms of sequence " + *seqid + " in " + duration.String())
+// computes a(first), ..., a(last) and prints the terms the transform f makes
+// of them, numbered from first. first is 1 for the transforms that work on
+// divisors.
+func computeTransformed(ctx context.Context, s seq.Sequence, f transform.Func, first, last int64, print func(n int64, v *big.Int) error) error {
+	emitDiagnostics(ctx, s, last)
+	a, err := seq.Range(s, first, last)
+	if err != nil {
+		return err
 	}
+	for i, v := range f(a) {
+		if err := print(first+int64(i), v); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// this handles the call to make life easier
-func handler(name string, params ...interface{}) (interface{}, int64) {
-	out1, out2, err := call(name, params...)
-	utils.HandleError(err)
-	idx := out2.(int64)
-	return out1, idx
+// sends the diagnostics of computing s up to a(last) to the sink in ctx.
+// seq.Range has no context, so it can't do this itself the way seq.Stream does.
+func emitDiagnostics(ctx context.Context, s seq.Sequence, last int64) {
+	for _, d := range seq.Diagnostics(s, last-s.Offset()+1) {
+		utils.Emit(ctx, d)
+	}
 }
 
-// this is based upon:
-// https://medium.com/@vicky.kurniawan/go-call-a-function-from-string-name-30b41dcb9e12
-func call(name string, params ...interface{}) (result interface{}, arg2 interface{}, err error) {
-	f := reflect.ValueOf(StubStorage[name])
+// computes a(first), ..., a(last), printing each term as it is found, and
+// stops once timeout has passed. Running out of time is not an error; it is
+// reported as a diagnostic instead.
+func streamRange(ctx context.Context, s seq.Sequence, first, last int64, timeout time.Duration, print func(n int64, v *big.Int) error) error {
+	if first < s.Offset() || last < first {
+		_, err := seq.Range(s, first, last) // reports the RangeError
+		return err
+	}
 
-	if len(params) != f.Type().NumIn() {
-		err = errors.New("error in call(): param count is out of bounds")
-		return
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	count := 0
+	var printErr error
+	err := seq.Stream(ctx, s, last-s.Offset()+1, func(n int64, v *big.Int) bool {
+		if n < first {
+			return true
+		}
+		count++
+		printErr = print(n, v)
+		return printErr == nil
+	})
+	if printErr != nil {
+		return printErr
 	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		utils.Emit(ctx, utils.NoteDiagnostic(s.ID(), "Stopped after "+timeout.String()+"; kept the "+strconv.Itoa(count)+" terms found so far."))
+		return nil
+	}
+	return err
+}
 
-	// build parameter list for call
-	in := make([]reflect.Value, len(params))
-	for k, param := range params {
-		in[k] = reflect.ValueOf(param)
+// prints the metadata of a sequence as an aligned list of fields
+func printMeta(w io.Writer, m seq.Metadata) error {
+	computes := "every term up to a(n)"
+	if m.Term {
+		computes = "a(n) directly"
+	}
+	if m.Stream {
+		computes = "streams the terms as they are found"
 	}
+	uses := strings.Join(m.Uses, ", ")
+	if uses == "" {
+		uses = "-"
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s\t%s\n", m.ID, m.Name)
+	fmt.Fprintf(tw, "link\t%s\n", m.Link())
+	fmt.Fprintf(tw, "offset\t%d\n", m.Offset)
+	fmt.Fprintf(tw, "type\t%s\n", m.Kind)
+	fmt.Fprintf(tw, "keywords\t%s\n", strings.Join(m.Keywords, ","))
+	fmt.Fprintf(tw, "growth\t%s\n", m.Growth)
+	fmt.Fprintf(tw, "speed\t%s\n", m.Speed)
+	fmt.Fprintf(tw, "computes\t%s\n", computes)
+	fmt.Fprintf(tw, "uses\t%s\n", uses)
+	fmt.Fprintf(tw, "date\t%s\n", m.Date)
+	return tw.Flush()
+}
 
-	// build result interface
-	var res []reflect.Value = f.Call(in)
-	result = res[0].Interface()
-	arg2 = res[1].Interface()
-	return
+// reports whether the flag with the given name was given on the command line
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
 }
 
-// the following is a (large) mapping from strings to the corresponding function
-// there has to be a better way to do this...
-var StubStorage = map[string]interface{}{
-	// thru100.go
-	"A000002": seq.A000002,
-	"A000004": seq.A000004,
-	"A000005": seq.A000005,
-	"A000006": seq.A000006,
-	"A000007": seq.A000007,
-	"A000008": seq.A000008,
-	"A000010": seq.A000010,
-	"A000011": seq.A000011,
-	"A000012": seq.A000012,
-	"A000013": seq.A000013,
-	"A000018": seq.A000018,
-	"A000021": seq.A000021,
-	"A000024": seq.A000024,
-	"A000027": seq.A000027,
-	"A000030": seq.A000030,
-	"A000032": seq.A000032,
-	"A000034": seq.A000034,
-	"A000035": seq.A000035,
-	"A000037": seq.A000037,
-	"A000038": seq.A000038,
-	"A000040": seq.A000040,
-	"A000041": seq.A000041,
-	"A000042": seq.A000042,
-	"A000043": seq.A000043,
-	"A000044": seq.A000044,
-	"A000045": seq.A000045,
-	"A000047": seq.A000047,
-	"A000049": seq.A000049,
-	"A000050": seq.A000050,
-	"A000051": seq.A000051,
-	"A000058": seq.A000058,
-	"A000059": seq.A000059,
-	"A000062": seq.A000062,
-	"A000064": seq.A000064,
-	"A000065": seq.A000065,
-	"A000068": seq.A000068,
-	"A000069": seq.A000069,
-	"A000070": seq.A000070,
-	"A000071": seq.A000071,
-	"A000073": seq.A000073,
-	"A000078": seq.A000078,
-	"A000079": seq.A000079,
-	"A000082": seq.A000082,
-	"A000086": seq.A000086,
-	"A000093": seq.A000093,
-	"A000094": seq.A000094,
-	"A000096": seq.A000096,
-	"A000097": seq.A000097,
-	"A000098": seq.A000098,
-	"A000100": seq.A000100,
-	// thru200.go
-	"A000101": seq.A000101,
-	"A000102": seq.A000102,
-	"A000108": seq.A000108,
-	"A000110": seq.A000110,
-	"A000111": seq.A000111,
-	"A000114": seq.A000114,
-	"A000115": seq.A000115,
-	"A000116": seq.A000116,
-	"A000117": seq.A000117,
-	"A000118": seq.A000118,
-	"A000120": seq.A000120,
-	"A000123": seq.A000123,
-	"A000124": seq.A000124,
-	"A000125": seq.A000125,
-	"A000126": seq.A000126,
-	"A000127": seq.A000127,
-	"A000128": seq.A000128,
-	"A000129": seq.A000129,
-	"A000133": seq.A000133,
-	"A000138": seq.A000138,
-	"A000139": seq.A000139,
-	"A000142": seq.A000142,
-	"A000148": seq.A000148,
-	"A000149": seq.A000149,
-	"A000150": seq.A000150,
-	"A000153": seq.A000153,
-	"A000158": seq.A000158,
-	"A000160": seq.A000160,
-	"A000161": seq.A000161,
-	"A000164": seq.A000164,
-	"A000165": seq.A000165,
-	"A000166": seq.A000166,
-	"A000168": seq.A000168,
-	"A000169": seq.A000169,
-	"A000172": seq.A000172,
-	"A000174": seq.A000174,
-	"A000178": seq.A000178,
-	"A000179": seq.A000179,
-	"A000182": seq.A000182,
-	"A000184": seq.A000184,
-	"A000188": seq.A000188,
-	"A000189": seq.A000189,
-	"A000190": seq.A000190,
-	"A000193": seq.A000193,
-	"A000194": seq.A000194,
-	"A000195": seq.A000195,
-	"A000196": seq.A000196,
-	"A000197": seq.A000197,
-	// thru300.go
-	"A000201": seq.A000201,
-	"A000202": seq.A000202,
-	"A000203": seq.A000203,
-	"A000204": seq.A000204,
-	"A000205": seq.A000205,
-	"A000207": seq.A000207,
-	"A000208": seq.A000208,
-	"A000209": seq.A000209,
-	"A000210": seq.A000210,
-	"A000211": seq.A000211,
-	"A000212": seq.A000212,
-	"A000213": seq.A000213,
-	"A000215": seq.A000215,
-	"A000216": seq.A000216,
-	"A000217": seq.A000217,
-	"A000218": seq.A000218,
-	"A000219": seq.A000219,
-	"A000221": seq.A000221,
-	"A000225": seq.A000225,
-	"A000227": seq.A000227,
-	"A000230": seq.A000230,
-	"A000231": seq.A000231,
-	"A000240": seq.A000240,
-	"A000244": seq.A000244,
-	"A000245": seq.A000245,
-	"A000246": seq.A000246,
-	"A000247": seq.A000247,
-	"A000248": seq.A000248,
-	"A000252": seq.A000252,
-	"A000253": seq.A000253,
-	"A000254": seq.A000254,
-	"A000255": seq.A000255,
-	"A000256": seq.A000256,
-	"A000257": seq.A000257,
-	"A000259": seq.A000259,
-	"A000260": seq.A000260,
-	"A000261": seq.A000261,
-	"A000262": seq.A000262,
-	"A000263": seq.A000263,
-	"A000265": seq.A000265,
-	"A000266": seq.A000266,
-	"A000267": seq.A000267,
-	"A000270": seq.A000270,
-	"A000271": seq.A000271,
-	"A000272": seq.A000272,
-	"A000274": seq.A000274,
-	"A000275": seq.A000275,
-	"A000276": seq.A000276,
-	"A000277": seq.A000277,
-	"A000278": seq.A000278,
-	"A000279": seq.A000279,
-	"A000280": seq.A000280,
-	"A000283": seq.A000283,
-	"A000284": seq.A000284,
-	"A000285": seq.A000285,
-	"A000286": seq.A000286,
-	"A000287": seq.A000287,
-	"A000288": seq.A000288,
-	"A000289": seq.A000289,
-	"A000290": seq.A000290,
-	"A000291": seq.A000291,
-	"A000292": seq.A000292,
-	"A000294": seq.A000294,
-	"A000295": seq.A000295,
-	"A000296": seq.A000296,
-	"A000297": seq.A000297,
-	// thru400.go
-	"A000301": seq.A000301,
-	"A000302": seq.A000302,
-	"A000304": seq.A000304,
-	"A000308": seq.A000308,
-	"A000309": seq.A000309,
-	"A000312": seq.A000312,
-	"A000313": seq.A000313,
-	"A000317": seq.A000317,
-	"A000318": seq.A000318,
-	"A000319": seq.A000319,
-	"A000321": seq.A000321,
-	"A000322": seq.A000322,
-	"A000324": seq.A000324,
-	"A000325": seq.A000325,
-	"A000326": seq.A000326,
-	"A000327": seq.A000327,
-	"A000328": seq.A000328,
-	"A000329": seq.A000329,
-	"A000330": seq.A000330,
-	"A000332": seq.A000332,
-	"A000336": seq.A000336,
-	"A000337": seq.A000337,
-	"A000339": seq.A000339,
-	"A000340": seq.A000340,
-	"A000344": seq.A000344,
-	"A000346": seq.A000346,
-	"A000350": seq.A000350,
-	"A000351": seq.A000351,
-	"A000352": seq.A000352,
-	"A000353": seq.A000353,
-	"A000354": seq.A000354,
-	"A000355": seq.A000355,
-	"A000356": seq.A000356,
-	"A000358": seq.A000358,
-	"A000363": seq.A000363,
-	"A000371": seq.A000371,
-	"A000381": seq.A000381,
-	"A000383": seq.A000383,
-	"A000384": seq.A000384,
-	"A000385": seq.A000385,
-	"A000387": seq.A000387,
-	"A000389": seq.A000389,
-	"A000392": seq.A000392,
-	"A000396": seq.A000396,
-	"A000399": seq.A000399,
-	"A000400": seq.A000400,
-	// otherseq.go
-	"A001065": seq.A001065,
-	"A001223": seq.A001223,
-	"A001611": seq.A001611,
-	"A001622": seq.A001622,
-	"A001840": seq.A001840,
-	"A002061": seq.A002061,
-	"A002386": seq.A002386,
-	"A003048": seq.A003048,
-	"A007947": seq.A007947,
-	"A011848": seq.A011848,
-	"A011858": seq.A011858,
-	"A027641": seq.A027641,
-	"A027642": seq.A027642,
-	"A032346": seq.A032346,
-	"A038040": seq.A038040,
-	"A052614": seq.A052614,
-	"A088218": seq.A088218,
-	"A128422": seq.A128422,
-	"A132269": seq.A132269,
-	"A164514": seq.A164514,
-	"A168014": seq.A168014,
+// returns the sink that renders diagnostics to stderr in the given format
+func diagnosticSink(format string) (utils.Sink, error) {
+	switch format {
+	case "text":
+		return func(d utils.Diagnostic) {
+			prefix := "Warning: "
+			if d.Kind == utils.Note {
+				prefix = "Note: "
+			}
+			utils.FprintWarning(os.Stderr, prefix+d.Message)
+		}, nil
+	case "json":
+		enc := json.NewEncoder(os.Stderr)
+		return func(d utils.Diagnostic) {
+			enc.Encode(d)
+		}, nil
+	case "none":
+		return nil, nil
+	}
+	return nil, errors.New("unknown -diag format " + strconv.Quote(format) + "; use text, json or none")
+}
+
+// handles an error in a pretty way for the user, then exits.
+// the seq and utils packages only ever return errors; exiting is left to main
+func handleError(e error) {
+	if e != nil {
+		utils.FprintError(os.Stderr, e.Error())
+		os.Exit(1)
+	}
 }
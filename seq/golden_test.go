@@ -0,0 +1,166 @@
+package seq
+
+import (
+	"OEIS/utils"
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// copies the golden files from OEIS b-files, never from the sequences here:
+// go test ./seq -run TestGolden -import ~/oeis/bfiles
+var importDir = flag.String("import", "", "a directory of OEIS b-files (b000045.txt or A000045.txt) to copy the golden files in testdata from")
+
+// the number of terms checked for each sequence, unless overridden below
+const goldenTerms = 20
+
+// sequences too slow to check goldenTerms terms of in a unit test
+var goldenCount = map[string]int64{
+	"A000018": 8,
+	"A000021": 8,
+	"A000024": 8,
+	"A000043": 12,
+	"A000047": 8,
+	"A000049": 8,
+	"A000050": 8,
+	"A000158": 12,
+	"A000160": 8,
+	"A000197": 8,
+	"A000205": 8,
+	"A000284": 12,
+	"A000286": 8,
+	"A000396": 3,
+	"A006880": 11,
+}
+
+// sequences known to disagree with the OEIS, and how. Their golden files
+// still hold the OEIS terms, and the test checks that they still disagree,
+// so that a fix is noticed; the ones without OEIS data in testdata yet are
+// skipped.
+var knownWrong = map[string]string{
+	"A000041": "registered with offset 1, the OEIS has p(0) = 1 at offset 0",
+	"A000111": "gives 1, 2, 3, 8, 25, ... rather than the zigzag numbers 1, 1, 1, 2, 5, ...",
+	"A000120": "registered with offset 1, the OEIS starts at a(0) = 0",
+	"A000177": "miscounts the partitions into 6 squares, e.g. a(2) = 2",
+	"A000188": "the n < seqlen loop never sets the last term, e.g. a(20) = 0, the OEIS has 2",
+	"A000189": "the n < seqlen loop never sets the last term, e.g. a(20) = 0, the OEIS has 2",
+	"A000190": "the n < seqlen loop never sets the last term, e.g. a(20) = 0, the OEIS has 2",
+	"A000207": "a(7) = 28, the OEIS has 27",
+	"A000262": "big.Float rounding in the sum, e.g. a(8) = 394352, the OEIS has 394353",
+	"A000296": "big.Float rounding in the double sum, e.g. a(3) = 0, the OEIS has 1",
+	"A001611": "registered with offset 1, the OEIS starts at a(0) = F(0) + 1",
+	"A027641": "a(1) = 1, the OEIS uses B_1 = -1/2",
+}
+
+// calls the function behind e directly, so the offset it returns can be
+// checked against the one it was registered with
+func generate(e *entry, seqlen int64) ([]*bint, int64, error) {
+	if e.kind == IntKind {
+		a, offset, err := e.intf(seqlen)
+		return utils.ToBigSlice(a), offset, err
+	}
+	return e.bigf(seqlen)
+}
+
+// TestGolden compares the first terms and the offset of every sequence with
+// its OEIS b-file in testdata
+func TestGolden(t *testing.T) {
+	for _, id := range IDs() {
+		e := registry[id]
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+			count := int64(goldenTerms)
+			if c, ok := goldenCount[e.id]; ok {
+				count = c
+			}
+			path := filepath.Join("testdata", e.id+".txt")
+			if *importDir != "" {
+				importGolden(t, e.id, path, count)
+				return
+			}
+
+			a, offset, err := generate(e, count)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if offset != e.offset {
+				t.Errorf("returned offset %d, registered with %d", offset, e.offset)
+			}
+			if int64(len(a)) != count {
+				t.Fatalf("returned %d terms, want %d", len(a), count)
+			}
+
+			f, err := os.Open(path)
+			if os.IsNotExist(err) && knownWrong[e.id] != "" {
+				t.Skip("known to be wrong, and there are no OEIS terms to check: " + knownWrong[e.id])
+			}
+			if err != nil {
+				t.Fatalf("%v (run with -import to copy it from the OEIS b-file)", err)
+			}
+			defer f.Close()
+			want, wantOffset, err := utils.ReadBFile(f)
+			if err != nil {
+				t.Fatalf("%s: %v", path, err)
+			}
+			if len(want) == 0 {
+				t.Fatalf("%s has no terms", path)
+			}
+
+			// the golden file may have fewer terms than are computed, when
+			// the OEIS has fewer
+			problems := make([]string, 0)
+			if wantOffset != e.offset {
+				problems = append(problems, "offset is "+strconv.FormatInt(e.offset, 10)+", the OEIS starts at "+strconv.FormatInt(wantOffset, 10))
+			}
+			for i := 0; i < len(a) && i < len(want); i++ {
+				if a[i].Cmp(want[i]) != 0 {
+					problems = append(problems, "a("+strconv.FormatInt(e.offset+int64(i), 10)+") = "+a[i].String()+", want "+want[i].String())
+					break // later terms usually follow from the first mismatch
+				}
+			}
+
+			if reason, ok := knownWrong[e.id]; ok {
+				if len(problems) == 0 {
+					t.Errorf("agrees with the OEIS now, so remove it from knownWrong (%s)", reason)
+				}
+				t.Skip("known to be wrong: " + reason)
+			}
+			for _, p := range problems {
+				t.Error(p)
+			}
+		})
+	}
+}
+
+// writes the first count terms of the OEIS b-file of id in importDir to the
+// golden file at path
+func importGolden(t *testing.T, id, path string, count int64) {
+	var f *os.File
+	var err error
+	for _, name := range []string{"b" + id[1:] + ".txt", id + ".txt"} {
+		if f, err = os.Open(filepath.Join(*importDir, name)); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		t.Skip("no b-file to import")
+	}
+	defer f.Close()
+	a, offset, err := utils.ReadBFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int64(len(a)) > count {
+		a = a[:count]
+	}
+	var buf bytes.Buffer
+	if err := utils.WriteBFile(&buf, a, offset); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,82 @@
+// ============================================================================
+// = guess.go
+// = 	Description		Guesses linear and holonomic recurrences for the
+// = 					implemented sequences from their first terms
+// = 	Note			See utils/guess.go for the Berlekamp-Massey algorithm,
+// = 					and utils/holonomic.go for the holonomic guesser
+// = 	Date			2026.10.17
+// ============================================================================
+
+package seq
+
+import (
+	"OEIS/utils"
+	"context"
+	"time"
+)
+
+// ############################### GUESSING #################################
+// ### a sequence is generated with a timeout, and the shortest recurrence
+// ### its terms satisfy is its guess. Failing that, a holonomic recurrence
+// ### of bounded order and degree is tried, which fits sequences like the
+// ### derangements. A sequence with neither isn't found to have one.
+
+// GuessReport is the recurrence guessed for a sequence
+type GuessReport struct {
+	Seq            Sequence
+	Terms          int         // the number of terms the guess was made from
+	Guess          utils.Guess // the linear recurrence, if Found
+	Found          bool
+	Holonomic      utils.HolonomicGuess // the holonomic recurrence, if FoundHolonomic
+	FoundHolonomic bool                 // only tried if there is no linear recurrence
+	Late           bool                 // whether the sequence ran out of time before seqlen terms
+	Err            error                // the error, if there were no terms
+}
+
+// GuessTerms guesses a linear recurrence for the terms, or else a holonomic
+// one of order at most maxOrder and degree at most maxDegree. A maxOrder of
+// 0 tries only linear recurrences.
+func GuessTerms(a []*bint, maxOrder, maxDegree int) (r GuessReport) {
+	r.Terms = len(a)
+	r.Guess, r.Found = utils.GuessRecurrence(a)
+	if !r.Found && maxOrder > 0 {
+		r.Holonomic, r.FoundHolonomic = utils.GuessHolonomic(a, maxOrder, maxDegree)
+	}
+	return r
+}
+
+// GuessSequence generates up to seqlen terms of s, or as many as it finds
+// within timeout, and guesses a recurrence for them with GuessTerms
+func GuessSequence(ctx context.Context, s Sequence, seqlen int64, timeout time.Duration, maxOrder, maxDegree int) (r GuessReport) {
+	r.Seq = s
+	defer func() {
+		if p := recover(); p != nil {
+			r.Found, r.FoundHolonomic, r.Err = false, false, panicError(s, p)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	a, late, err := growPrefix(ctx, s, 10, seqlen, nil)
+	if len(a) == 0 && err != nil {
+		r.Err = err
+		return r
+	}
+	r = GuessTerms(a, maxOrder, maxDegree)
+	r.Seq, r.Late = s, late
+	return r
+}
+
+// GuessAll guesses a recurrence for every sequence in seqs, several at a
+// time. The reports are in the order of seqs.
+func GuessAll(ctx context.Context, seqs []Sequence, seqlen int64, timeout time.Duration, maxOrder, maxDegree int) []GuessReport {
+	reports := make([]GuessReport, len(seqs))
+	index := make(map[string]int, len(seqs))
+	for i, s := range seqs {
+		index[s.ID()] = i
+	}
+	concurrently(seqs, func(s Sequence) {
+		reports[index[s.ID()]] = GuessSequence(ctx, s, seqlen, timeout, maxOrder, maxDegree)
+	})
+	return reports
+}
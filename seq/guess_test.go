@@ -0,0 +1,44 @@
+package seq
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestGuessSequence guesses the recurrences of the Fibonacci and Pell
+// numbers, the holonomic ones of the derangements and the ménage numbers,
+// and finds none for the primes
+func TestGuessSequence(t *testing.T) {
+	tests := []struct {
+		id        string
+		found     bool
+		order     int
+		holonomic bool
+	}{
+		{"A000045", true, 2, false},
+		{"A000129", true, 2, false},
+		{"A000290", true, 3, false},
+		{"A000166", false, 2, true},
+		{"A000179", false, 3, true},
+		{"A000040", false, 0, false},
+	}
+	for _, tt := range tests {
+		s, _ := Lookup(tt.id)
+		r := GuessSequence(context.Background(), s, 40, 5*time.Second, 3, 3)
+		if r.Err != nil {
+			t.Fatalf("%s: %v", tt.id, r.Err)
+		}
+		if r.Terms != 40 {
+			t.Errorf("%s: guessed from %d terms, want 40", tt.id, r.Terms)
+		}
+		order := r.Guess.Order()
+		if r.FoundHolonomic {
+			order = r.Holonomic.Recurrence.Order()
+		}
+		if r.Found != tt.found || r.FoundHolonomic != tt.holonomic || order != tt.order {
+			t.Errorf("%s: found %v (holonomic %v) with order %d, want %v (holonomic %v) with order %d",
+				tt.id, r.Found, r.FoundHolonomic, order, tt.found, tt.holonomic, tt.order)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// ============================================================================
+// = internalformat.go
+// = 	Description		Writes and reads sequences in the OEIS internal format
+// = 	Note			See https://oeis.org/eishelp1.html for the format
+// = 	Date			2026.10.17
+// ============================================================================
+
+package seq
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ########################### INTERNAL FORMAT ##############################
+// ### every line of an entry is "%X A000045 ...", where X names the field:
+// ### %I the ID, %S %T %U the terms, %N the name, %O the offset and %K the
+// ### keywords. Submissions and corrections are sent to the OEIS this way.
+
+// the most characters of terms an entry may have, commas included
+const maxDataLen = 260
+
+// the length at which the %S and %T lines are wrapped; %U takes the rest
+const dataLineLen = 70
+
+// Record is a sequence as an entry in the OEIS internal format: its metadata
+// and its leading terms
+type Record struct {
+	Metadata
+	Terms []*bint // starting at a(Offset)
+}
+
+// NewRecord computes as many terms of s as fit in an entry, or as many as it
+// finds before ctx is done, and returns them with the metadata of s
+func NewRecord(ctx context.Context, s Sequence) (r Record, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicError(s, p)
+		}
+	}()
+
+	// every term takes at least two characters, a digit and a comma
+	terms, _, err := growPrefix(ctx, s, 10, maxDataLen/2+1, func(a []*bint) bool {
+		return len(joinTerms(a)) > maxDataLen
+	})
+	if len(terms) == 0 {
+		if err == nil {
+			err = ctx.Err()
+		}
+		return r, err
+	}
+	return Record{Metadata: s.Meta(), Terms: terms}, nil
+}
+
+// WriteInternal writes r as an entry in the OEIS internal format. Only the
+// terms that fit in maxDataLen characters are written.
+func WriteInternal(w io.Writer, r Record) error {
+	bw := bufio.NewWriter(w)
+	line := func(field, text string) {
+		bw.WriteString("%" + field + " " + r.ID)
+		if text != "" {
+			bw.WriteString(" " + text)
+		}
+		bw.WriteString("\n")
+	}
+
+	line("I", "")
+	for i, text := range wrapTerms(r.Terms) {
+		line([]string{"S", "T", "U"}[i], text)
+	}
+	line("N", r.Name)
+	line("O", strconv.FormatInt(r.Offset, 10)+","+strconv.Itoa(firstLarge(r.Terms)))
+	line("K", strings.Join(r.Keywords, ","))
+	return bw.Flush()
+}
+
+// ReadInternal reads the entries in r, in the OEIS internal format. The %I,
+// %S, %T, %U, %N, %O and %K lines are read into each Record, and the others
+// (comments, formulas, references, ...) are skipped. The Kind of a Record is
+// IntKind if every term fits in an int64.
+func ReadInternal(r io.Reader) ([]Record, error) {
+	records := make([]Record, 0)
+	var cur *Record
+	data := ""
+
+	// adds the terms of the current record once all of its lines are read
+	finish := func(line int) error {
+		if cur == nil {
+			return nil
+		}
+		terms, err := splitTerms(data)
+		if err != nil {
+			return internalError(line, cur.ID+": "+err.Error())
+		}
+		cur.Terms, cur.Kind = terms, IntKind
+		for _, v := range terms {
+			if !v.IsInt64() {
+				cur.Kind = BigKind
+			}
+		}
+		records = append(records, *cur)
+		return nil
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for sc.Scan() {
+		line++
+		text := strings.TrimSpace(sc.Text())
+		if text == "" {
+			continue
+		}
+
+		fields := strings.SplitN(text, " ", 3)
+		if len(fields[0]) != 2 || fields[0][0] != '%' || len(fields) < 2 {
+			return nil, internalError(line, "expected \"%X A000000 ...\", got "+strconv.Quote(text))
+		}
+		id := fields[1]
+		if len(id) != 7 || id[0] != 'A' || strings.Trim(id[1:], "0123456789") != "" {
+			return nil, internalError(line, "invalid ID "+strconv.Quote(id))
+		}
+		rest := ""
+		if len(fields) == 3 {
+			rest = strings.TrimSpace(fields[2])
+		}
+
+		// a new ID starts a new entry
+		if cur == nil || cur.ID != id {
+			if err := finish(line); err != nil {
+				return nil, err
+			}
+			cur, data = &Record{Metadata: Metadata{ID: id, Keywords: []string{}}}, ""
+		}
+
+		switch fields[0][1] {
+		case 'S', 'T', 'U':
+			data += rest
+		case 'N':
+			cur.Name = rest
+		case 'O':
+			offset, _, _ := strings.Cut(rest, ",")
+			n, err := strconv.ParseInt(offset, 10, 64)
+			if err != nil {
+				return nil, internalError(line, "invalid offset "+strconv.Quote(rest))
+			}
+			cur.Offset = n
+		case 'K':
+			cur.Keywords = splitList(rest)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if err := finish(line); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// returns the terms as they appear in an entry: "0,1,1,2,3"
+func joinTerms(a []*bint) string {
+	s := make([]string, len(a))
+	for i, v := range a {
+		s[i] = v.String()
+	}
+	return strings.Join(s, ",")
+}
+
+// splits the terms of an entry into the text of its %S, %T and %U lines,
+// dropping the terms past maxDataLen characters. Every line but the last
+// ends with a comma, so the lines can be joined back together.
+func wrapTerms(a []*bint) []string {
+	lines := []string{""}
+	total := 0
+	for _, v := range a {
+		term := v.String()
+		if total+len(term) > maxDataLen {
+			break
+		}
+		cur := &lines[len(lines)-1]
+		if *cur != "" && len(lines) < 3 && len(*cur)+len(term)+1 > dataLineLen {
+			*cur += ","
+			lines = append(lines, "")
+			cur = &lines[len(lines)-1]
+		} else if *cur != "" {
+			*cur += ","
+		}
+		*cur += term
+		total += len(term) + 1
+	}
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// parses the terms of an entry: "0,1,1,2,3" or "0,1,1,2,3,"
+func splitTerms(data string) ([]*bint, error) {
+	a := make([]*bint, 0)
+	data = strings.TrimSuffix(data, ",")
+	if data == "" {
+		return a, nil
+	}
+	for _, f := range strings.Split(data, ",") {
+		v, ok := zero().SetString(f, 10)
+		if !ok {
+			return nil, errors.New("invalid term " + strconv.Quote(f))
+		}
+		a = append(a, v)
+	}
+	return a, nil
+}
+
+// returns the position, counting from 1, of the first term whose absolute
+// value exceeds 1, which is the second number of %O. It is 1 if there is none.
+func firstLarge(a []*bint) int {
+	for i, v := range a {
+		if abs(v).Cmp(inew(1)) > 0 {
+			return i + 1
+		}
+	}
+	return 1
+}
+
+// the error for a malformed line of an entry
+func internalError(line int, msg string) error {
+	return errors.New("internal format line " + strconv.Itoa(line) + ": " + msg)
+}
@@ -0,0 +1,88 @@
+package seq
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestInternalRoundTrip writes the Fibonacci and Ménage numbers as entries
+// and reads them back
+func TestInternalRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	want := make([]Record, 0)
+	for _, id := range []string{"A000045", "A000179"} {
+		s, _ := Lookup(id)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		r, err := NewRecord(ctx, s)
+		cancel()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := WriteInternal(&buf, r); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, r)
+	}
+
+	for i, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if (strings.HasPrefix(line, "%S") || strings.HasPrefix(line, "%T")) && len(line) > len("%S A000045 ")+dataLineLen {
+			t.Errorf("line %d is too long: %q", i+1, line)
+		}
+	}
+	if !strings.Contains(buf.String(), "%O A000045 0,4\n") || !strings.Contains(buf.String(), "%O A000179 0,5\n") {
+		t.Errorf("wrong %%O lines in\n%s", buf.String())
+	}
+
+	got, err := ReadInternal(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("read %d records, want %d", len(got), len(want))
+	}
+	for i, r := range got {
+		w := want[i]
+		data := joinTerms(r.Terms)
+		if r.ID != w.ID || r.Name != w.Name || r.Offset != w.Offset || !reflect.DeepEqual(r.Keywords, w.Keywords) {
+			t.Errorf("%s: read %+v, want %+v", w.ID, r.Metadata, w.Metadata)
+		}
+		if len(data) > maxDataLen || !strings.HasPrefix(joinTerms(w.Terms), data) {
+			t.Errorf("%s: read the terms %s", w.ID, data)
+		}
+	}
+	if got[0].Kind != IntKind || got[1].Kind != BigKind {
+		t.Errorf("got kinds %v and %v, want int64 and big.Int", got[0].Kind, got[1].Kind)
+	}
+}
+
+// TestReadInternal reads an entry with fields that aren't kept, and rejects
+// malformed ones
+func TestReadInternal(t *testing.T) {
+	entry := `%I A000012 M0003
+%S A000012 1,1,1,1,1,1,
+%T A000012 1,1,1
+%N A000012 The simplest sequence of positive numbers: the all 1's sequence.
+%C A000012 Also the continued fraction for golden ratio...
+%O A000012 0,1
+%K A000012 nonn,cons,easy
+`
+	got, err := ReadInternal(strings.NewReader(entry))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := got[0]
+	if len(got) != 1 || r.ID != "A000012" || len(r.Terms) != 9 || r.Offset != 0 || r.Kind != IntKind ||
+		!reflect.DeepEqual(r.Keywords, []string{"nonn", "cons", "easy"}) {
+		t.Errorf("ReadInternal = %+v", got)
+	}
+
+	for _, bad := range []string{"%S A000012 1,x,1\n", "S A000012 1,1\n", "%O A12 0,1\n", "%O A000012 zero\n"} {
+		if _, err := ReadInternal(strings.NewReader(bad)); err == nil {
+			t.Errorf("ReadInternal(%q) succeeded, want an error", bad)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// ============================================================================
+// = lookup.go
+// = 	Description		Finds the sequences whose terms contain a list of terms
+// = 	Note			Like the OEIS lookup, but over the implemented sequences
+// = 	Date			2026.10.17
+// ============================================================================
+
+package seq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ################################ LOOKUP ##################################
+// ### every sequence generates a prefix of its terms concurrently, each with
+// ### its own timeout, and the prefixes are searched for the query.
+
+// Match is a sequence whose terms contain the query as a contiguous run
+type Match struct {
+	Seq   Sequence
+	N     int64   // the index of the first matching term: a(N) = query[0]
+	Terms []*bint // every term that was generated, starting at a(offset)
+}
+
+// Find generates up to seqlen terms of every sequence and returns those that
+// contain query, in ascending order of ID, along with the IDs of the
+// sequences that ran out of time. Those are searched with the terms they
+// found within timeout. Sequences that panic are skipped.
+//
+// Sequences without a StreamFunc or TermFunc cannot be interrupted, so they
+// keep computing in the background after their timeout.
+func Find(ctx context.Context, query []*bint, seqlen int64, timeout time.Duration) ([]Match, []string) {
+	matches := make([]Match, 0)
+	late := make([]string, 0)
+	if len(query) == 0 {
+		return matches, late
+	}
+
+	var mu sync.Mutex
+	concurrently(All(), func(s Sequence) {
+		m, match, isLate := find(ctx, s, query, seqlen, timeout)
+		mu.Lock()
+		defer mu.Unlock()
+		if match {
+			matches = append(matches, m)
+		}
+		if isLate {
+			late = append(late, s.ID())
+		}
+	})
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Seq.ID() < matches[j].Seq.ID() })
+	sort.Strings(late)
+	return matches, late
+}
+
+// calls f on every sequence in seqs, several at a time, and waits for them all.
+// Most of the time is spent waiting out timeouts, so there are more workers
+// than CPUs.
+func concurrently(seqs []Sequence, f func(s Sequence)) {
+	jobs := make(chan Sequence)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.GOMAXPROCS(0)+4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for s := range jobs {
+				f(s)
+			}
+		}()
+	}
+	for _, s := range seqs {
+		jobs <- s
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// generates the terms of s and searches them for query. late reports
+// whether s ran out of time before computing seqlen terms.
+func find(ctx context.Context, s Sequence, query []*bint, seqlen int64, timeout time.Duration) (m Match, match, late bool) {
+	m.Seq = s
+	defer func() {
+		if r := recover(); r != nil {
+			match = false // a broken sequence shouldn't take the lookup down with it
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	largest := zero()
+	for _, v := range query {
+		if abs(v).Cmp(largest) > 0 {
+			largest = abs(v)
+		}
+	}
+	growth := s.Meta().Growth
+	m.Terms, late, _ = growPrefix(ctx, s, int64(len(query)), seqlen, func(a []*bint) bool {
+		// the terms of exponential (and faster) sequences only grow, so once
+		// they pass the query no later term can match
+		return growth >= Exponential && len(a) > 0 && abs(a[len(a)-1]).Cmp(largest) > 0
+	})
+
+	for i := 0; i+len(query) <= len(m.Terms); i++ {
+		if equalTerms(m.Terms[i:i+len(query)], query) {
+			m.N = s.Offset() + int64(i)
+			return m, true, late
+		}
+	}
+	return m, false, late
+}
+
+// computes up to seqlen terms of s before ctx is done. Most sequences
+// generate every term in one piece, so this asks for more and more terms,
+// starting with min: a slow sequence still returns the terms it managed, and
+// one that fails past some length returns the terms before it did, along with
+// the error. stop, if not nil, ends the search once it returns true for the
+// terms so far. late reports whether ctx was done first.
+func growPrefix(ctx context.Context, s Sequence, min, seqlen int64, stop func(a []*bint) bool) (terms []*bint, late bool, err error) {
+	if min < 1 {
+		min = 1
+	}
+	for n := min; ; n *= 2 {
+		if n > seqlen {
+			n = seqlen
+		}
+		a := make([]*bint, 0, n)
+		err = Stream(ctx, s, n, func(_ int64, v *bint) bool {
+			a = append(a, v)
+			return true
+		})
+		if len(a) > len(terms) {
+			terms = a
+		}
+		if ctx.Err() != nil {
+			return terms, true, err
+		}
+		if err != nil || n == seqlen || (stop != nil && stop(a)) {
+			return terms, false, err
+		}
+	}
+}
+
+// reports whether a and b hold the same terms
+func equalTerms(a, b []*bint) bool {
+	for i := range b {
+		if a[i].Cmp(b[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// the error for a sequence that panicked while computing its terms
+func panicError(s Sequence, r interface{}) error {
+	return errors.New("sequence " + s.ID() + " panicked: " + fmt.Sprint(r))
+}
@@ -0,0 +1,37 @@
+package seq
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestFind looks up the start of the Catalan numbers, which should be found
+// at n = 0 even though slow sequences run out of time
+func TestFind(t *testing.T) {
+	query := make([]*bint, 0)
+	for _, v := range []int64{1, 1, 2, 5, 14, 42} {
+		query = append(query, inew(v))
+	}
+
+	start := time.Now()
+	matches, late := Find(context.Background(), query, 8, time.Second)
+	if elapsed := time.Since(start); elapsed > time.Minute {
+		t.Errorf("took %v; the %d slow sequences should be cut off after their timeout", elapsed, len(late))
+	}
+
+	found := map[string]int64{}
+	for _, m := range matches {
+		found[m.Seq.ID()] = m.N
+		for i, v := range query {
+			if m.Terms[m.N-m.Seq.Offset()+int64(i)].Cmp(v) != 0 {
+				t.Errorf("%s: a(%d) doesn't match the query", m.Seq.ID(), m.N+int64(i))
+			}
+		}
+	}
+	for _, id := range []string{"A000108", "A088218"} {
+		if n, ok := found[id]; !ok || n != 0 {
+			t.Errorf("%s: found at %d (%v), want n = 0", id, n, ok)
+		}
+	}
+}
@@ -0,0 +1,423 @@
+// ============================================================================
+// = metadata.go
+// = 	Description		Structured metadata about every programmed sequence
+// = 	Note			Names and keywords follow the OEIS; see Meta()
+// = 	Date			2026.10.17
+// ============================================================================
+
+package seq
+
+import (
+	"strings"
+)
+
+// ############################## METADATA ##################################
+// ### what is known about each sequence, kept as data rather than in the doc
+// ### comments so it can be queried. Offset and Kind come from the registry.
+
+// Growth is how fast the terms of a sequence grow with n
+type Growth int
+
+const (
+	UnknownGrowth     Growth = iota // erratic, or not worked out yet
+	Bounded                         // the terms never exceed some constant
+	Sublinear                       // o(n), e.g. sqrt(n) or log(n)
+	Linear                          // about n, up to log factors (e.g. the primes)
+	Polynomial                      // about n^k for some k > 1
+	Subexponential                  // faster than any polynomial, slower than c^n (e.g. partitions)
+	Exponential                     // about c^n
+	Factorial                       // about n! or n^n
+	DoublyExponential               // about c^(d^n) or faster
+)
+
+// String returns the name of the growth rate
+func (g Growth) String() string {
+	switch g {
+	case Bounded:
+		return "bounded"
+	case Sublinear:
+		return "sublinear"
+	case Linear:
+		return "linear"
+	case Polynomial:
+		return "polynomial"
+	case Subexponential:
+		return "subexponential"
+	case Exponential:
+		return "exponential"
+	case Factorial:
+		return "factorial"
+	case DoublyExponential:
+		return "doubly exponential"
+	}
+	return "unknown"
+}
+
+// MarshalText lets the growth rate appear by name in JSON
+func (g Growth) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+// Speed is how long the first 20 terms of a sequence take to compute
+type Speed int
+
+const (
+	Fast     Speed = iota // well under a second
+	Moderate              // up to a few seconds
+	Slow                  // longer; usually a search for the terms
+)
+
+// String returns the name of the speed class
+func (s Speed) String() string {
+	switch s {
+	case Moderate:
+		return "moderate"
+	case Slow:
+		return "slow"
+	}
+	return "fast"
+}
+
+// MarshalText lets the speed class appear by name in JSON
+func (s Speed) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Keywords are the OEIS keywords used in the metadata.
+// See https://oeis.org/eishelp2.html#RK for their full meaning.
+var Keywords = []string{
+	"nonn", // the terms are nonnegative
+	"sign", // some terms are negative
+	"tabl", // a triangle read by rows
+	"cons", // the decimal expansion of a constant
+	"fini", // the sequence is finite
+	"hard", // further terms are hard to find
+	"more", // more terms are wanted
+}
+
+// Metadata describes a sequence: its OEIS name and keywords, and how it is
+// computed here
+type Metadata struct {
+	ID       string   `json:"id"`       // the OEIS A-number, e.g. "A000045"
+	Name     string   `json:"name"`     // the OEIS name of the sequence
+	Keywords []string `json:"keywords"` // OEIS keywords, see Keywords
+	Offset   int64    `json:"offset"`   // the index of the first term
+	Kind     Kind     `json:"kind"`     // the type the terms are computed with
+	Date     string   `json:"date"`     // when the sequence was programmed
+	Growth   Growth   `json:"growth"`   // how fast the terms grow
+	Speed    Speed    `json:"speed"`    // how long the first terms take to compute
+	Uses     []string `json:"uses"`     // the utils calculations and generators it is computed with
+	Term     bool     `json:"term"`     // a(n) is computed directly, see TermSequence
+	Stream   bool     `json:"stream"`   // the terms are streamed as they are found, see Stream
+}
+
+// Link returns the URL of the sequence's page on the OEIS
+func (m Metadata) Link() string {
+	return "https://oeis.org/" + m.ID
+}
+
+// HasKeyword reports whether the sequence has the OEIS keyword k
+func (m Metadata) HasKeyword(k string) bool {
+	for _, kw := range m.Keywords {
+		if kw == k {
+			return true
+		}
+	}
+	return false
+}
+
+// Meta returns the metadata of the sequence
+func (e *entry) Meta() Metadata {
+	info := metadata[e.id]
+	return Metadata{
+		ID:       e.id,
+		Name:     info.name,
+		Keywords: splitList(info.keywords),
+		Offset:   e.offset,
+		Kind:     e.kind,
+		Date:     info.date,
+		Growth:   info.growth,
+		Speed:    info.speed,
+		Uses:     splitList(info.uses),
+		Term:     e.termf != nil,
+		Stream:   e.streamf != nil,
+	}
+}
+
+// splits a comma-separated list from the metadata table
+func splitList(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, ",")
+}
+
+// Search returns the sequences whose ID or name contains every word of query,
+// ignoring case, in ascending order of ID
+func Search(query string) []Sequence {
+	words := strings.Fields(strings.ToLower(query))
+	found := make([]Sequence, 0)
+	for _, s := range All() {
+		text := strings.ToLower(s.ID() + " " + metadata[s.ID()].name)
+		match := true
+		for _, w := range words {
+			match = match && strings.Contains(text, w)
+		}
+		if match {
+			found = append(found, s)
+		}
+	}
+	return found
+}
+
+// info is one row of the metadata table. keywords and uses are comma-separated.
+// uses lists the exported functions of utils/calc.go, generator.go and
+// check.go that the sequence calls, including through other sequences.
+type info struct {
+	name     string
+	keywords string
+	date     string
+	growth   Growth
+	speed    Speed
+	uses     string
+}
+
+// ############################ METADATA TABLE ##############################
+
+var metadata = map[string]info{
+	"A000002": {"Kolakoski sequence: a(n) is length of n-th run; a(1) = 1; sequence consists just of 1's and 2's.", "nonn", "October 08, 2021", Bounded, Fast, "Kolakoski"},
+	"A000004": {"The zero sequence.", "nonn", "October 08, 2021", Bounded, Fast, ""},
+	"A000005": {"d(n) (also called tau(n) or sigma_0(n)), the number of divisors of n.", "nonn", "October 08, 2021", Sublinear, Fast, "Tau"},
+	"A000006": {"Integer part of square root of n-th prime.", "nonn", "October 08, 2021", Sublinear, Fast, "Isqrtarray,Primes"},
+	"A000007": {"The characteristic function of {0}: a(n) = 0^n.", "nonn", "October 08, 2021", Bounded, Fast, ""},
+	"A000008": {"Number of ways of making change for n cents using coins of 1, 2, 5, 10 cents.", "nonn", "October 08, 2021", Polynomial, Fast, "MakeChange"},
+	"A000010": {"Euler totient function phi(n): count numbers <= n and prime to n.", "nonn", "October 08, 2021", Linear, Fast, "Totient"},
+	"A000011": {"Number of n-bead necklaces (turning over is allowed) where complements are equivalent.", "nonn", "October 08, 2021", Exponential, Fast, "Factors,Totient"},
+	"A000012": {"The simplest sequence of positive numbers: the all 1's sequence.", "nonn", "October 08, 2021", Bounded, Fast, ""},
+	"A000013": {"Number of n-bead binary necklaces with beads of 2 colors where the colors may be swapped but turning over is not allowed.", "nonn", "December 10, 2021", Exponential, Fast, "Totient"},
+	"A000018": {"Number of positive integers <= 2^n of form x^2 + 16*y^2.", "nonn", "December 12, 2021", Exponential, Slow, "Repr"},
+	"A000021": {"Number of positive integers <= 2^n of form x^2 + 12*y^2.", "nonn", "December 12, 2021", Exponential, Slow, "Repr"},
+	"A000024": {"Number of positive integers <= 2^n of form x^2 + 10*y^2.", "nonn", "December 12, 2021", Exponential, Slow, "Repr"},
+	"A000027": {"The positive integers.", "nonn", "October 09, 2021", Linear, Fast, ""},
+	"A000030": {"Initial digit of n.", "nonn", "October 09, 2021", Bounded, Fast, "GetFirstDigit"},
+	"A000032": {"Lucas numbers beginning at 2: L(n) = L(n-1) + L(n-2), L(0) = 2, L(1) = 1.", "nonn", "October 09, 2021", Exponential, Fast, "Lucas"},
+	"A000034": {"Period 2: repeat [1, 2]; a(n) = 1 + (n mod 2).", "nonn", "October 08, 2021", Bounded, Fast, ""},
+	"A000035": {"Period 2: repeat [0, 1]; a(n) = n mod 2; parity of n.", "nonn", "October 09, 2021", Bounded, Fast, ""},
+	"A000037": {"Numbers that are not squares (or, the nonsquares).", "nonn", "October 09, 2021", Linear, Fast, ""},
+	"A000038": {"Twice A000007.", "nonn", "October 09, 2021", Bounded, Fast, ""},
+	"A000040": {"The prime numbers.", "nonn", "October 09, 2021", Linear, Fast, "NewPrimeIter,Primes"},
+	"A000041": {"a(n) is the number of partitions of n (the partition numbers).", "nonn", "October 09, 2021", Subexponential, Fast, "CountParts"},
+	"A000042": {"Unary representation of natural numbers.", "nonn", "October 09, 2021", Exponential, Fast, ""},
+	"A000043": {"Mersenne exponents: primes p such that 2^p - 1 is prime.", "nonn,hard,more", "December 07, 2021", Exponential, Slow, "IsPrime"},
+	"A000044": {"Dying rabbits: a(0) = 1; for 1 <= n <= 12, a(n) = Fibonacci(n); for n >= 13, a(n) = a(n-1) + a(n-2) - a(n-13).", "nonn", "December 07, 2021", Exponential, Fast, ""},
+	"A000045": {"Fibonacci numbers: F(n) = F(n-1) + F(n-2) with F(0) = 0 and F(1) = 1.", "nonn", "December 07, 2021", Exponential, Fast, "Fibonacci,Nacci"},
+	"A000047": {"Number of integers <= 2^n of form x^2 - 2*y^2.", "nonn", "December 12, 2021", Exponential, Slow, "Repr"},
+	"A000049": {"Number of positive integers <= 2^n of form 3*x^2 + 4*y^2.", "nonn", "December 12, 2021", Exponential, Slow, "Repr"},
+	"A000050": {"Number of positive integers <= 2^n of form x^2 + y^2.", "nonn", "December 12, 2021", Exponential, Slow, "Repr"},
+	"A000051": {"a(n) = 2^n + 1.", "nonn", "December 12, 2021", Exponential, Fast, "Powers"},
+	"A000058": {"Sylvester's sequence: a(n+1) = a(n)^2 - a(n) + 1, with a(0) = 2.", "nonn", "December 07, 2021", DoublyExponential, Fast, ""},
+	"A000059": {"Numbers k such that (2k)^4 + 1 is prime.", "nonn", "December 07, 2021", Linear, Fast, "IsPrime"},
+	"A000062": {"A Beatty sequence: a(n) = floor(n/(e-2)).", "nonn", "December 07, 2021", Linear, Fast, ""},
+	"A000064": {"Partial sums of (unordered) ways of making change for n cents using coins of 1, 2, 5, 10 cents.", "nonn", "December 07, 2021", Polynomial, Fast, "MakeChange"},
+	"A000065": {"-1 + number of partitions of n.", "nonn", "December 07, 2021", Subexponential, Fast, "CountParts"},
+	"A000068": {"Numbers k such that k^4 + 1 is prime.", "nonn", "December 07, 2021", Linear, Fast, "IsPrime"},
+	"A000069": {"Odious numbers: numbers with an odd number of 1's in their binary expansion.", "nonn", "December 07, 2021", Linear, Fast, ""},
+	"A000070": {"a(n) = Sum_{k=0..n} p(k) where p(k) = number of partitions of k (A000041).", "nonn", "December 07, 2021", Subexponential, Fast, "CountParts,Sum"},
+	"A000071": {"a(n) = Fibonacci(n) - 1.", "nonn", "December 07, 2021", Exponential, Fast, "Nacci"},
+	"A000073": {"Tribonacci numbers: a(n) = a(n-1) + a(n-2) + a(n-3) with a(0) = a(1) = 0, a(2) = 1.", "nonn", "December 07, 2021", Exponential, Fast, ""},
+	"A000078": {"Tetranacci numbers: a(n) = a(n-1) + a(n-2) + a(n-3) + a(n-4) with a(0) = a(1) = a(2) = 0 and a(3) = 1.", "nonn", "December 07, 2021", Exponential, Fast, ""},
+	"A000079": {"Powers of 2: a(n) = 2^n.", "nonn", "December 07, 2021", Exponential, Fast, "Powers"},
+	"A000082": {"a(n) = n^2*Product_{p|n} (1 + 1/p).", "nonn", "December 07, 2021", Polynomial, Fast, "DedekindPsi"},
+	"A000086": {"Number of solutions to x^2 - x + 1 == 0 (mod n).", "nonn", "December 12, 2021", Sublinear, Fast, ""},
+	"A000093": {"a(n) = floor(n^(3/2)).", "nonn", "December 07, 2021", Polynomial, Fast, ""},
+	"A000094": {"Number of trees of diameter 4.", "nonn", "December 07, 2021", Subexponential, Fast, "CountParts"},
+	"A000096": {"a(n) = n*(n+3)/2.", "nonn", "December 07, 2021", Polynomial, Fast, ""},
+	"A000097": {"Number of partitions of n if there are two kinds of 1's and two kinds of 2's.", "nonn", "December 07, 2021", Subexponential, Fast, "CountParts,Sum"},
+	"A000098": {"Number of partitions of n if there are two kinds of 1, two kinds of 2 and two kinds of 3.", "nonn", "December 07, 2021", Subexponential, Fast, "CountParts,Sum"},
+	"A000100": {"a(n) is the number of compositions of n in which the maximal part is 3.", "nonn", "December 07, 2021", Exponential, Fast, "Nacci"},
+	"A000101": {"Increasing gaps between primes (upper end).", "nonn,hard,more", "December 07, 2021", Exponential, Fast, "NewPrimeIter"},
+	"A000102": {"a(n) is the number of compositions of n in which the maximal part is 4.", "nonn", "December 07, 2021", Exponential, Fast, ""},
+	"A000108": {"Catalan numbers: C(n) = binomial(2n,n)/(n+1) = (2n)!/(n!(n+1)!).", "nonn", "December 07, 2021", Exponential, Fast, ""},
+	"A000110": {"Bell or exponential numbers: number of ways to partition a set of n labeled elements.", "nonn", "December 07, 2021", Factorial, Fast, ""},
+	"A000111": {"Euler or up/down numbers: number of alternating permutations on n letters.", "nonn", "December 07, 2021", Factorial, Fast, ""},
+	"A000114": {"Number of cusps of principal congruence subgroup GAMMA-hat(n).", "nonn", "December 12, 2021", Polynomial, Fast, "IsPrime"},
+	"A000115": {"Denumerants: expansion of 1/((1-x)*(1-x^2)*(1-x^5)).", "nonn", "December 07, 2021", Polynomial, Fast, ""},
+	"A000116": {"Number of even sequences with period 2n (bisection of A000013).", "nonn", "December 07, 2021", Exponential, Fast, "Totient"},
+	"A000117": {"Number of even sequences with period 2n (bisection of A000011).", "nonn", "December 09, 2021", Exponential, Fast, "Factors,Totient"},
+	"A000118": {"Number of ways of writing n as a sum of 4 squares; also theta series of lattice Z^4.", "nonn", "December 09, 2021", Linear, Fast, "Factors"},
+	"A000120": {"1's-counting sequence: number of 1's in binary expansion of n (or the binary weight of n).", "nonn", "December 07, 2021", Sublinear, Fast, ""},
+	"A000123": {"Number of binary partitions: number of partitions of 2n into powers of 2.", "nonn", "December 09, 2021", Subexponential, Fast, ""},
+	"A000124": {"Central polygonal numbers (the Lazy Caterer's sequence): n(n+1)/2 + 1.", "nonn", "December 09, 2021", Polynomial, Fast, ""},
+	"A000125": {"Cake numbers: maximal number of pieces resulting from n planar cuts through a cube (or cake): C(n+1,3) + n + 1.", "nonn", "December 09, 2021", Polynomial, Fast, ""},
+	"A000126": {"A nonlinear binomial sum.", "nonn", "December 09, 2021", Exponential, Fast, ""},
+	"A000127": {"Maximal number of regions obtained by joining n points around a circle by straight lines.", "nonn", "December 09, 2021", Polynomial, Fast, ""},
+	"A000128": {"A nonlinear binomial sum.", "nonn", "December 09, 2021", Exponential, Fast, "Nacci"},
+	"A000129": {"Pell numbers: a(0) = 0, a(1) = 1; for n > 1, a(n) = 2*a(n-1) + a(n-2).", "nonn", "December 09, 2021", Exponential, Fast, ""},
+	"A000133": {"Number of Boolean functions of n variables.", "nonn", "December 09, 2021", DoublyExponential, Fast, ""},
+	"A000138": {"Expansion of e.g.f. exp(-x^4/4)/(1-x).", "nonn", "December 09, 2021", Factorial, Fast, "Monomial,NewSeries"},
+	"A000139": {"a(n) = 2*(3*n)!/((2*n+1)!*((n+1)!)).", "nonn", "December 10, 2021", Exponential, Fast, ""},
+	"A000142": {"Factorial numbers n! = 1*2*3*4*...*n.", "nonn", "December 10, 2021", Factorial, Fast, "Factorial"},
+	"A000148": {"Number of partitions into non-integral powers.", "nonn", "2025.02.08", Polynomial, Fast, ""},
+	"A000149": {"a(n) = floor(e^n).", "nonn", "December 10, 2021", Exponential, Fast, ""},
+	"A000150": {"Number of dissections of an n-gon, rooted at an exterior edge, asymmetric with respect to that edge.", "nonn", "December 12, 2021", Exponential, Fast, ""},
+	"A000153": {"a(n) = n*a(n-1) + (n-2)*a(n-2), with a(0) = 0, a(1) = 1.", "nonn", "December 10, 2021", Factorial, Fast, ""},
+	"A000158": {"Number of partitions into non-integral powers.", "nonn", "December 10, 2021", Polynomial, Moderate, ""},
+	"A000160": {"Number of partitions into non-integral powers.", "nonn", "December 10, 2021", Polynomial, Slow, ""},
+	"A000161": {"Number of partitions of n into 2 squares.", "nonn", "December 10, 2021", Sublinear, Fast, ""},
+	"A000164": {"Number of partitions of n into 3 squares (allowing part zero).", "nonn", "December 10, 2021", Sublinear, Fast, "IsSquare"},
+	"A000165": {"Double factorial of even numbers: (2n)!! = 2^n*n!.", "nonn", "December 10, 2021", Factorial, Fast, "Factorial"},
+	"A000166": {"Subfactorial or rencontres numbers, or derangements: number of permutations of n elements with no fixed points.", "nonn", "December 10, 2021", Factorial, Fast, ""},
+	"A000168": {"a(n) = 2*3^n*(2*n)!/(n!*(n+2)!).", "nonn", "December 10, 2021", Exponential, Fast, ""},
+	"A000169": {"Number of labeled rooted trees with n nodes: n^(n-1).", "nonn", "December 10, 2021", Factorial, Fast, ""},
+	"A000172": {"The Franel number a(n) = Sum_{k=0..n} binomial(n,k)^3.", "nonn", "December 10, 2021", Exponential, Fast, "Binomial"},
+	"A000174": {"Number of partitions of n into 5 squares.", "nonn", "December 10, 2021", Polynomial, Fast, ""},
+	"A000177": {"Number of partitions of n into 6 squares.", "nonn", "December 10, 2021", Polynomial, Moderate, ""},
+	"A000178": {"Superfactorials: product of first n factorials.", "nonn", "December 10, 2021", Factorial, Fast, ""},
+	"A000179": {"Ménage numbers: a(0) = 1, a(1) = -1, and for n >= 2, a(n) = number of permutations s of [0, ..., n-1] such that s(i) != i and s(i) != i+1 (mod n) for all i.", "sign", "December 12, 2021", Factorial, Fast, ""},
+	"A000182": {"Tangent (or \"Zag\") numbers: e.g.f. tan(x), also (up to signs) e.g.f. tanh(x).", "nonn", "December 12, 2021", Factorial, Fast, "Bernoulli"},
+	"A000184": {"Number of genus 0 rooted maps with 3 faces with n vertices.", "nonn", "December 12, 2021", Exponential, Fast, ""},
+	"A000188": {"Number of solutions to x^2 == 0 (mod n); also square root of largest square dividing n.", "nonn", "December 12, 2021", Sublinear, Fast, ""},
+	"A000189": {"Number of solutions to x^3 == 0 (mod n).", "nonn", "December 12, 2021", Sublinear, Fast, ""},
+	"A000190": {"Number of solutions to x^4 == 0 (mod n).", "nonn", "December 12, 2021", Sublinear, Fast, ""},
+	"A000193": {"Nearest integer to log n.", "nonn", "December 12, 2021", Sublinear, Fast, ""},
+	"A000194": {"n appears 2n times, for n >= 1; also nearest integer to square root of n.", "nonn", "December 12, 2021", Sublinear, Fast, ""},
+	"A000195": {"a(n) = floor(log(n)).", "nonn", "December 12, 2021", Sublinear, Fast, ""},
+	"A000196": {"Integer part of square root of n. Or, number of positive squares <= n. Or, n appears 2n+1 times.", "nonn", "December 12, 2021", Sublinear, Fast, "Isqrt"},
+	"A000197": {"a(n) = (n!)!.", "nonn", "December 12, 2021", DoublyExponential, Slow, ""},
+	"A000201": {"Lower Wythoff sequence (a Beatty sequence): a(n) = floor(n*phi), where phi = (1+sqrt(5))/2 = A001622.", "nonn", "December 12, 2021", Linear, Fast, ""},
+	"A000202": {"a(8i+j) = 13i + a(j), where 1 <= j <= 8.", "nonn", "December 12, 2021", Linear, Fast, ""},
+	"A000203": {"a(n) = sigma(n), the sum of the divisors of n. Also called sigma_1(n).", "nonn", "December 12, 2021", Linear, Fast, "DivisorSigma"},
+	"A000204": {"Lucas numbers (beginning with 1): L(n) = L(n-1) + L(n-2) with L(1) = 1, L(2) = 3.", "nonn", "December 12, 2021", Exponential, Fast, "Lucas"},
+	"A000205": {"Number of positive integers <= 2^n of form x^2 + 3*y^2.", "nonn", "December 12, 2021", Exponential, Slow, "Repr"},
+	"A000207": {"Number of inequivalent ways of dissecting a regular (n+2)-gon into n triangles by n-1 non-intersecting diagonals under rotations and reflections.", "nonn", "December 13, 2021", Exponential, Fast, "ShiftBigSliceRight"},
+	"A000208": {"Number of even sequences with period 2n.", "nonn", "December 14, 2021", Exponential, Fast, "Totient"},
+	"A000209": {"Nearest integer to tan n.", "sign", "December 14, 2021", UnknownGrowth, Fast, ""},
+	"A000210": {"A Beatty sequence: floor(n*(e-1)).", "nonn", "December 14, 2021", Linear, Fast, ""},
+	"A000211": {"a(n) = a(n-1) + a(n-2) - 2, a(0) = 4, a(1) = 3.", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000212": {"a(n) = floor(n^2/3).", "nonn", "December 14, 2021", Polynomial, Fast, ""},
+	"A000213": {"Tribonacci numbers: a(n) = a(n-1) + a(n-2) + a(n-3) with a(0) = a(1) = a(2) = 1.", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000215": {"Fermat numbers: a(n) = 2^(2^n) + 1.", "nonn", "December 14, 2021", DoublyExponential, Fast, ""},
+	"A000216": {"Take sum of squares of digits of previous term, starting with 2.", "nonn", "December 14, 2021", Bounded, Fast, "SumSquares"},
+	"A000217": {"Triangular numbers: a(n) = binomial(n+1,2) = n*(n+1)/2 = 0 + 1 + 2 + ... + n.", "nonn", "December 14, 2021", Polynomial, Fast, ""},
+	"A000218": {"Take sum of squares of digits of previous term; start with 3.", "nonn", "December 14, 2021", Bounded, Fast, "SumSquares"},
+	"A000219": {"Number of planar partitions (or plane partitions) of n.", "nonn", "December 14, 2021", Subexponential, Fast, "Sigma"},
+	"A000221": {"Take sum of squares of digits of previous term, starting with 5.", "nonn", "December 14, 2021", Bounded, Fast, "SumSquares"},
+	"A000225": {"a(n) = 2^n - 1.", "nonn", "December 14, 2021", Exponential, Fast, "Powers"},
+	"A000227": {"Nearest integer to e^n.", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000230": {"a(0) = 2; for n >= 1, a(n) = smallest prime p such that there is a gap of exactly 2n between p and next prime, or -1 if no such prime exists.", "nonn,hard,more", "December 14, 2021", UnknownGrowth, Fast, "NewPrimeIter"},
+	"A000231": {"Number of inequivalent Boolean functions of n variables under action of complementing group.", "nonn", "December 14, 2021", DoublyExponential, Moderate, ""},
+	"A000240": {"Rencontres numbers: number of permutations of [n] with exactly one fixed point.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000244": {"Powers of 3: a(n) = 3^n.", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000245": {"a(n) = 3*(2*n)!/((n+2)!*(n-1)!).", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000246": {"Number of permutations in the symmetric group S_n that have odd order.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000247": {"a(n) = 2^n - n - 2.", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000248": {"Expansion of e.g.f. exp(x*exp(x)).", "nonn", "December 14, 2021", Factorial, Fast, "Monomial"},
+	"A000252": {"Number of invertible 2 X 2 matrices mod n.", "nonn", "December 14, 2021", Polynomial, Fast, "IsPrime"},
+	"A000253": {"a(n) = 2*a(n-1) - a(n-2) + a(n-3) + 2^(n-1).", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000254": {"Unsigned Stirling numbers of first kind, s(n+1,2): a(n+1) = (n+1)*a(n) + n!.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000255": {"a(n) = n*a(n-1) + (n-1)*a(n-2), a(0) = 1, a(1) = 1.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000256": {"Number of simple triangulations of the plane with n nodes.", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000257": {"Number of rooted bicubic maps: a(n) = (8n-4)*a(n-1)/(n+2) for n >= 2, a(0) = a(1) = 1.", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000259": {"Number of certain rooted planar maps.", "nonn", "December 14, 2021", Exponential, Fast, "Nacci"},
+	"A000260": {"Number of rooted simplicial 3-polytopes with n+3 nodes; or rooted 3-connected triangulations with 2n+2 faces.", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000261": {"a(n) = n*a(n-1) + (n-3)*a(n-2), with a(1) = 0, a(2) = 1.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000262": {"Number of \"sets of lists\": number of partitions of {1,...,n} into any number of lists, where a list means an ordered subset.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000263": {"Number of partitions into non-integral powers.", "nonn", "December 14, 2021", Polynomial, Fast, ""},
+	"A000265": {"Remove all factors of 2 from n; or largest odd divisor of n; or odd part of n.", "nonn", "December 14, 2021", Linear, Fast, "LargestOddDivisor"},
+	"A000266": {"Expansion of e.g.f. exp(-x^2/2)/(1-x).", "nonn", "December 14, 2021", Factorial, Fast, "Monomial,NewSeries"},
+	"A000267": {"Integer part of square root of 4n+1.", "nonn", "December 14, 2021", Sublinear, Fast, "Isqrt"},
+	"A000270": {"For n >= 2, a(n) = b(n+1) + b(n) + b(n-1), where the b(i) are the ménage numbers A000179; a(0) = a(1) = 1.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000271": {"Sums of ménage numbers.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000272": {"Number of trees on n labeled nodes: n^(n-2) with a(0) = 1.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000274": {"Number of permutations of length n with 2 consecutive ascending pairs.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000275": {"Coefficients of a Bessel function (reciprocal of J_0(z)); also pairs of permutations with rise/rise forbidden.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000276": {"Associated Stirling numbers.", "nonn", "December 14, 2021", Factorial, Fast, ""},
+	"A000277": {"3*n - 2*floor(sqrt(4*n+5)) + 5.", "nonn", "December 14, 2021", Linear, Fast, "Isqrt"},
+	"A000278": {"a(n) = a(n-1) + a(n-2)^2 for n >= 2 with a(0) = 0 and a(1) = 1.", "nonn", "December 14, 2021", DoublyExponential, Fast, ""},
+	"A000279": {"Card matching: coefficients B[n,1] of t in the reduced hit polynomial A[n,n,n](t).", "nonn", "December 14, 2021", Factorial, Fast, "Binomial"},
+	"A000280": {"a(n) = a(n-1) + a(n-2)^3.", "nonn", "December 14, 2021", DoublyExponential, Fast, ""},
+	"A000283": {"a(n) = a(n-1)^2 + a(n-2)^2 for n >= 2 with a(0) = 0 and a(1) = 1.", "nonn", "December 14, 2021", DoublyExponential, Fast, ""},
+	"A000284": {"a(n) = a(n-1)^3 + a(n-2) with a(0) = 0, a(1) = 1.", "nonn", "December 14, 2021", DoublyExponential, Slow, ""},
+	"A000285": {"a(0) = 1, a(1) = 4, and a(n) = a(n-1) + a(n-2) for n >= 2.", "nonn", "December 14, 2021", Exponential, Fast, ""},
+	"A000286": {"Number of positive integers <= 2^n of form 2*x^2 + 5*y^2.", "nonn", "December 15, 2021", Exponential, Slow, "Repr"},
+	"A000287": {"Number of rooted polyhedral graphs with n edges.", "nonn", "December 15, 2021", Exponential, Fast, ""},
+	"A000288": {"Tetranacci numbers: a(n) = a(n-1) + a(n-2) + a(n-3) + a(n-4) with a(0) = a(1) = a(2) = a(3) = 1.", "nonn", "December 15, 2021", Exponential, Fast, "Nacci,NacciRecurrence"},
+	"A000289": {"A nonlinear recurrence: a(n) = a(n-1)^2 - 3*a(n-1) + 3 (for n > 1).", "nonn", "December 15, 2021", DoublyExponential, Fast, ""},
+	"A000290": {"The squares: a(n) = n^2.", "nonn", "December 15, 2021", Polynomial, Fast, "Exponents"},
+	"A000291": {"Number of bipartite partitions of n white objects and 2 black ones.", "nonn", "December 15, 2021", Subexponential, Fast, "CountParts,Sum"},
+	"A000292": {"Tetrahedral (or triangular pyramidal) numbers: a(n) = C(n+2,3) = n*(n+1)*(n+2)/6.", "nonn", "December 15, 2021", Polynomial, Fast, ""},
+	"A000294": {"Expansion of g.f. Product_{k >= 1} (1 - x^k)^(-k*(k+1)/2).", "nonn", "December 15, 2021", Subexponential, Fast, "Sigma"},
+	"A000295": {"Eulerian numbers (Euler's triangle: column k=2 of A008292, column k=1 of A173018).", "nonn", "December 15, 2021", Exponential, Fast, ""},
+	"A000296": {"Set partitions without singletons: number of partitions of an n-set into blocks of size > 1.", "nonn", "December 15, 2021", Factorial, Fast, ""},
+	"A000297": {"a(n) = (n+1)*(n+3)*(n+8)/6.", "nonn", "December 15, 2021", Polynomial, Fast, ""},
+	"A000301": {"a(n) = a(n-1)*a(n-2) with a(0) = 1, a(1) = 2; also a(n) = 2^Fibonacci(n).", "nonn", "2025.01.26", DoublyExponential, Fast, "Nacci"},
+	"A000302": {"Powers of 4: a(n) = 4^n.", "nonn", "2025.01.26", Exponential, Fast, "Powers"},
+	"A000304": {"a(n) = a(n-1)*a(n-2) with a(0) = 2, a(1) = 3.", "nonn", "2025.01.27", DoublyExponential, Fast, ""},
+	"A000308": {"a(n) = a(n-1)*a(n-2)*a(n-3) with a(1) = 1, a(2) = 2 and a(3) = 3.", "nonn", "2025.01.27", DoublyExponential, Fast, ""},
+	"A000309": {"Number of rooted planar bridgeless cubic maps with 2n nodes.", "nonn", "2025.01.27", Exponential, Fast, ""},
+	"A000312": {"a(n) = n^n; number of labeled mappings from n points to themselves (endofunctions).", "nonn", "2025.01.27", Factorial, Fast, ""},
+	"A000313": {"Number of permutations of length n with 3 consecutive ascending pairs.", "nonn", "2025.01.27", Factorial, Fast, ""},
+	"A000317": {"a(n+1) = a(n)^2 - a(n)*a(n-1) + a(n-1)^2, with a(0) = 1, a(1) = 2.", "nonn", "2025.01.27", DoublyExponential, Fast, ""},
+	"A000318": {"a(n) = 2^(4n-2)*A000182(n).", "nonn", "2025.01.27", Factorial, Fast, "Bernoulli"},
+	"A000319": {"a(n) = floor(b(n)), where b(n) = tan(b(n-1)), b(0) = 1.", "sign", "2025.01.27", UnknownGrowth, Fast, ""},
+	"A000321": {"H_n(-1/2), where H_n(x) is Hermite polynomial of degree n.", "sign", "2025.01.30", Factorial, Fast, ""},
+	"A000322": {"Pentanacci numbers: a(n) = a(n-1) + a(n-2) + a(n-3) + a(n-4) + a(n-5) with a(0) = a(1) = a(2) = a(3) = a(4) = 1.", "nonn", "2025.01.30", Exponential, Fast, "Nacci,NacciRecurrence"},
+	"A000324": {"A nonlinear recurrence: a(0) = 1, a(1) = 5, a(n) = a(n-1)^2 - 4*a(n-1) + 4 for n > 1.", "nonn", "2025.01.30", DoublyExponential, Fast, ""},
+	"A000325": {"a(n) = 2^n - n.", "nonn", "2025.02.08", Exponential, Fast, ""},
+	"A000326": {"Pentagonal numbers: a(n) = n*(3*n-1)/2.", "nonn", "2025.02.08", Polynomial, Fast, ""},
+	"A000327": {"Number of partitions into non-integral powers.", "nonn", "2025.02.08", Polynomial, Fast, ""},
+	"A000328": {"Number of points of norm <= n^2 in square lattice.", "nonn", "2025.02.08", Polynomial, Fast, ""},
+	"A000329": {"Nearest integer to b(n), where b(n) = tan(b(n-1)), b(0) = 1.", "sign", "2025.02.08", UnknownGrowth, Fast, ""},
+	"A000330": {"Square pyramidal numbers: a(n) = 0^2 + 1^2 + 2^2 + ... + n^2 = n*(n+1)*(2*n+1)/6.", "nonn", "2025.02.08", Polynomial, Fast, ""},
+	"A000332": {"Binomial coefficient binomial(n,4) = n*(n-1)*(n-2)*(n-3)/24.", "nonn", "2025.02.08", Polynomial, Fast, ""},
+	"A000336": {"a(n) = a(n-1)*a(n-2)*a(n-3)*a(n-4); for n < 5, a(n) = n.", "nonn", "2025.02.08", DoublyExponential, Fast, ""},
+	"A000337": {"a(n) = (n-1)*2^n + 1.", "nonn", "2025.02.08", Exponential, Fast, ""},
+	"A000339": {"Number of partitions into non-integral powers.", "nonn", "2025.02.09", Polynomial, Fast, ""},
+	"A000340": {"a(0) = 1, a(n) = 3*a(n-1) + n + 1.", "nonn", "2025.02.09", Exponential, Fast, ""},
+	"A000344": {"a(n) = 5*binomial(2n, n-2)/(n+3).", "nonn", "2025.02.09", Exponential, Fast, ""},
+	"A000346": {"a(n) = 2^(2*n+1) - binomial(2*n+1, n+1).", "nonn", "2025.02.09", Exponential, Fast, ""},
+	"A000350": {"Numbers m such that Fibonacci(m) ends with m.", "nonn", "2025.02.09", UnknownGrowth, Fast, "Nacci"},
+	"A000351": {"Powers of 5: a(n) = 5^n.", "nonn", "2025.02.09", Exponential, Fast, "Powers"},
+	"A000352": {"One half of the number of permutations of [n] such that the differences have three runs with the same signs.", "nonn", "2025.02.09", Exponential, Fast, ""},
+	"A000353": {"Primes p == 7, 19, 23 (mod 40) such that (p-1)/2 is also prime.", "nonn", "2025.02.09", Linear, Fast, "IsPrime"},
+	"A000354": {"Expansion of e.g.f. exp(-x)/(1-2*x).", "nonn", "2025.02.09", Factorial, Fast, "Monomial,NewSeries"},
+	"A000355": {"Primes p == 3, 9, 11 (mod 20) such that 2p+1 is also prime.", "nonn", "2025.02.09", Linear, Fast, "IsPrime"},
+	"A000356": {"Number of rooted cubic maps with 2n nodes and a distinguished Hamiltonian cycle: (2n)!(2n+1)!/(n!^2*(n+1)!(n+2)!).", "nonn", "2025.02.09", Exponential, Fast, ""},
+	"A000358": {"Number of binary necklaces of length n with no subsequence 00, excluding the necklace \"0\".", "nonn", "2025.02.09", Exponential, Fast, "EulerTotientBig,Nacci"},
+	"A000363": {"Number of permutations of [n] with exactly 2 increasing runs of length at least 2.", "nonn", "2025.02.09", Exponential, Fast, ""},
+	"A000371": {"a(n) = Sum_{k=0..n} (-1)^(n-k)*binomial(n,k)*2^(2^k).", "nonn", "2025.02.09", DoublyExponential, Fast, ""},
+	"A000381": {"Essentially same as A001611.", "nonn", "2025.02.09", Exponential, Fast, "Nacci,ShiftBigSliceLeft"},
+	"A000383": {"Hexanacci numbers with a(0) = ... = a(5) = 1.", "nonn", "2025.02.09", Exponential, Fast, "Nacci,NacciRecurrence"},
+	"A000384": {"Hexagonal numbers: a(n) = n*(2*n-1).", "nonn", "2025.02.09", Polynomial, Fast, ""},
+	"A000385": {"Convolution of A000203 with itself.", "nonn", "2025.02.09", Polynomial, Fast, "DivisorSigma"},
+	"A000387": {"Rencontres numbers: number of permutations of [n] with exactly two fixed points.", "nonn", "2025.02.09", Factorial, Fast, "Recontres"},
+	"A000389": {"Binomial coefficients C(n,5).", "nonn", "2025.02.09", Polynomial, Fast, ""},
+	"A000392": {"Stirling numbers of second kind S(n,3).", "nonn", "2025.02.09", Exponential, Fast, "Stirling2"},
+	"A000396": {"Perfect numbers k: k is equal to the sum of the proper divisors of k.", "nonn,hard,more", "2025.02.09", DoublyExponential, Slow, "Sigma"},
+	"A000399": {"Unsigned Stirling numbers of first kind s(n,3).", "nonn", "2025.02.09", Factorial, Fast, "Stirling1"},
+	"A000400": {"Powers of 6: a(n) = 6^n.", "nonn", "2025.02.09", Exponential, Fast, "Powers"},
+	"A000720": {"pi(n), the number of primes <= n. Sometimes called PrimePi(n) to distinguish it from the number 3.14159...", "nonn", "2026.10.17", Sublinear, Fast, "PrimePi,PrimesUpTo"},
+	"A001065": {"Sum of proper divisors (or aliquot parts) of n: sum of divisors of n that are less than n.", "nonn", "December 15, 2021", Linear, Fast, "Factors,Sum"},
+	"A001223": {"Prime gaps: differences between consecutive primes.", "nonn", "December 15, 2021", Sublinear, Fast, "Primes"},
+	"A001611": {"a(n) = Fibonacci(n) + 1.", "nonn", "December 15, 2021", Exponential, Fast, "Nacci"},
+	"A001622": {"Decimal expansion of golden ratio phi (or tau) = (1 + sqrt(5))/2.", "nonn,cons", "December 15, 2021", Bounded, Fast, ""},
+	"A001840": {"Expansion of x/((1 - x)^2 * (1 - x^3)).", "nonn", "December 16, 2021", Polynomial, Fast, "NewSeries"},
+	"A002061": {"Central polygonal numbers: a(n) = n^2 - n + 1.", "nonn", "December 16, 2021", Polynomial, Fast, ""},
+	"A002386": {"Increasing gaps between primes (lower end).", "nonn,hard,more", "December 16, 2021", Exponential, Fast, "NewPrimeIter"},
+	"A003048": {"a(n+1) = n*a(n) - (-1)^n.", "nonn", "December 10, 2021", Factorial, Fast, ""},
+	"A006880": {"Number of primes < 10^n.", "nonn,hard", "2026.10.17", Exponential, Slow, "PrimePi"},
+	"A007053": {"Number of primes <= 2^n.", "nonn", "2026.10.17", Exponential, Fast, "PrimePi"},
+	"A007947": {"Largest squarefree number dividing n: the squarefree kernel of n, rad(n), radical of n.", "nonn", "December 16, 2021", Linear, Fast, "Radical"},
+	"A011848": {"a(n) = floor(binomial(n,2)/2).", "nonn", "December 16, 2021", Polynomial, Fast, "Binomial"},
+	"A011858": {"a(n) = floor(n*(n-1)/5).", "nonn", "December 16, 2021", Polynomial, Fast, ""},
+	"A027641": {"Numerator of Bernoulli number B_n.", "sign", "December 12, 2021", Factorial, Fast, "Bernoulli"},
+	"A027642": {"Denominator of Bernoulli number B_n.", "nonn", "December 12, 2021", UnknownGrowth, Fast, "Bernoulli"},
+	"A032346": {"Shifts 1 place right under inverse binomial transform.", "nonn", "December 07, 2021", Factorial, Fast, ""},
+	"A038040": {"a(n) = n*d(n), where d(n) = number of divisors of n (A000005).", "nonn", "December 16, 2021", Polynomial, Fast, "Tau"},
+	"A052614": {"E.g.f. 1/((1-x)(1-x^4)).", "nonn", "December 16, 2021", Factorial, Fast, "NewSeries"},
+	"A088218": {"Total number of leaves in all rooted ordered trees with n edges.", "nonn", "December 07, 2021", Exponential, Fast, ""},
+	"A128422": {"Projective plane crossing number of K_{4,n}.", "nonn", "December 16, 2021", Polynomial, Fast, ""},
+	"A132269": {"Product_{k>=0} (1 + floor(n/2^k)).", "nonn", "December 16, 2021", Subexponential, Fast, ""},
+	"A164514": {"1 followed by the numbers that are not squares.", "nonn", "December 16, 2021", Linear, Fast, ""},
+	"A168014": {"Sum of all parts of all partitions of n into equal parts that do not contain 1 as a part.", "nonn", "December 16, 2021", Linear, Fast, "Tau"},
+}
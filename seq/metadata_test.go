@@ -0,0 +1,204 @@
+package seq
+
+import (
+	"OEIS/utils"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// TestMetadataComplete checks that every registered sequence has metadata,
+// that its nonn/sign keyword agrees with its golden terms, and that the
+// sequences too slow for the golden test aren't marked fast
+func TestMetadataComplete(t *testing.T) {
+	for id := range metadata {
+		if _, ok := Lookup(id); !ok {
+			t.Errorf("%s has metadata but is not registered", id)
+		}
+	}
+
+	for _, s := range All() {
+		m := s.Meta()
+		if _, ok := metadata[s.ID()]; !ok {
+			t.Errorf("%s has no metadata", s.ID())
+			continue
+		}
+		if m.Name == "" || m.Date == "" {
+			t.Errorf("%s: name and date are required", s.ID())
+		}
+		if _, slow := goldenCount[s.ID()]; slow && m.Speed == Fast {
+			t.Errorf("%s: marked fast, but goldenCount limits its terms", s.ID())
+		}
+		for _, k := range m.Keywords {
+			if !contains(Keywords, k) {
+				t.Errorf("%s: unknown keyword %q", s.ID(), k)
+			}
+		}
+
+		f, err := os.Open(filepath.Join("testdata", s.ID()+".txt"))
+		if os.IsNotExist(err) && knownWrong[s.ID()] != "" {
+			continue // no OEIS terms yet, see TestGolden
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, _, err := utils.ReadBFile(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		negative := false
+		for _, v := range a {
+			negative = negative || v.Sign() < 0
+		}
+		if negative != m.HasKeyword("sign") || negative == m.HasKeyword("nonn") {
+			t.Errorf("%s: keywords %v, but the golden terms are negative: %v", s.ID(), m.Keywords, negative)
+		}
+	}
+}
+
+// TestMetadataUses checks the uses column against the code: the utils
+// functions called by A<id>, term<id> and stream<id>, and by every function
+// of this package they call in turn
+func TestMetadataUses(t *testing.T) {
+	fset := token.NewFileSet()
+
+	// the functions that may appear in the uses column, with the arithmetic
+	// functions of multiplicative.go and the series and recurrence constructors
+	tracked := map[string]bool{}
+	for _, name := range []string{"calc.go", "generator.go", "check.go", "primes.go", "factor.go", "multiplicative.go", "series.go", "recurrence.go", "holonomic.go"} {
+		file, err := parser.ParseFile(fset, filepath.Join("..", "utils", name), nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil && d.Name.IsExported() {
+					tracked[d.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				// the predefined functions, e.g. utils.Totient
+				for _, spec := range d.Specs {
+					if v, ok := spec.(*ast.ValueSpec); ok && d.Tok == token.VAR {
+						for _, id := range v.Names {
+							tracked[id.Name] = id.IsExported()
+						}
+					}
+				}
+			}
+		}
+	}
+	delete(tracked, "InitIslice") // allocating a slice isn't worth listing
+	delete(tracked, "InitBslice")
+
+	// for each function of this package, the utils functions it calls and
+	// the identifiers that may refer to other functions of this package
+	pkgs, err := parser.ParseDir(fset, ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := map[string][]string{}
+	refs := map[string][]string{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv != nil {
+					continue
+				}
+				name := fn.Name.Name
+				calls[name], refs[name] = nil, nil
+				ast.Inspect(fn.Body, func(n ast.Node) bool {
+					switch v := n.(type) {
+					case *ast.SelectorExpr:
+						if x, ok := v.X.(*ast.Ident); ok && x.Name == "utils" {
+							if tracked[v.Sel.Name] {
+								calls[name] = append(calls[name], v.Sel.Name)
+							}
+							return false
+						}
+						return true // e.g. utils.Tau in utils.Tau.Table
+					case *ast.Ident:
+						if v.Obj == nil || v.Obj.Kind == ast.Fun { // not a local variable
+							refs[name] = append(refs[name], v.Name)
+						}
+					}
+					return true
+				})
+			}
+		}
+	}
+
+	for _, id := range IDs() {
+		uses := map[string]bool{}
+		seen := map[string]bool{}
+		var walk func(name string)
+		walk = func(name string) {
+			if _, ok := calls[name]; !ok || seen[name] {
+				return
+			}
+			seen[name] = true
+			for _, u := range calls[name] {
+				uses[u] = true
+			}
+			for _, r := range refs[name] {
+				walk(r)
+			}
+		}
+		walk(id)
+		walk("term" + id)
+		walk("stream" + id)
+
+		want := make([]string, 0, len(uses))
+		for u := range uses {
+			want = append(want, u)
+		}
+		sort.Strings(want)
+		if got := registry[id].Meta().Uses; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: uses %v, the code calls %v", id, got, want)
+		}
+	}
+}
+
+// TestSearch checks that every word of the query must match, ignoring case
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"stirling", []string{"A000254", "A000276", "A000392", "A000399"}},
+		{"Rencontres  NUMBERS", []string{"A000166", "A000240", "A000387"}},
+		{"partitions squares", []string{"A000161", "A000164", "A000174", "A000177"}},
+		{"A000045", []string{"A000045"}},
+		{"no such sequence", []string{}},
+	}
+	for _, tt := range tests {
+		got := make([]string, 0)
+		for _, s := range Search(tt.query) {
+			got = append(got, s.ID())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+// reports whether list contains s
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -9,23 +9,75 @@ package seq
 
 import (
 	"OEIS/utils"
-	"fmt"
+	"context"
 	"math"
 )
 
+const (
+	OVERFLOW_A006880 = 19 // 10^19 doesn't fit in an int64
+	OVERFLOW_A007053 = 63 // nor does 2^63
+)
+
+// a(n) = (n-1) (a(n-1) + a(n-2)), which is a(n) = n a(n-1) - (-1)^n made
+// homogeneous
+var holA003048 = utils.NewHolonomic([][]int64{{1}, {-1, 1}, {-1, 1}}, []int64{1, 2})
+
+// registers every sequence in this file
+func init() {
+	registerInt("A001065", 1, A001065)
+	registerInt("A001223", 1, A001223)
+	registerBig("A001611", 1, A001611)
+	registerInt("A001622", 1, A001622)
+	registerInt("A001840", 0, A001840)
+	registerInt("A002061", 0, A002061)
+	registerInt("A002386", 1, A002386)
+	registerBig("A003048", 0, A003048)
+	registerInt("A006880", 0, A006880)
+	registerInt("A007053", 0, A007053)
+	registerInt("A007947", 1, A007947)
+	registerInt("A011848", 0, A011848)
+	registerInt("A011858", 0, A011858)
+	registerBig("A027641", 0, A027641)
+	registerBig("A027642", 0, A027642)
+	registerBig("A032346", 0, A032346)
+	registerInt("A038040", 1, A038040)
+	registerBig("A052614", 0, A052614)
+	registerBig("A088218", 0, A088218)
+	registerInt("A128422", 1, A128422)
+	registerInt("A132269", 1, A132269)
+	registerInt("A164514", 1, A164514)
+	registerInt("A168014", 0, A168014)
+
+	// sequences that compute a(n) directly
+	registerTerm("A001065", termA001065)
+
+	// sequences that stream the terms they search for
+	registerStream("A002386", streamA002386)
+
+	// sequences that warn about how long they take, or how accurate they are
+	registerDiagnostics("A002386", always(utils.LongCalculationWarning("A002386")))
+	registerDiagnostics("A006880", primePiWarning("A006880", 10))
+	registerDiagnostics("A007053", primePiWarning("A007053", 2))
+}
+
 /**
  * A001065 computes the sum of proper divisors (or aliquot parts)
  *  of n: sum of divisors of n that are less than n.
  * Date		December 15, 2021
  * Link		https://oeis.org/A001065
  */
-func A001065(seqlen int64) ([]int64, int64) {
+func A001065(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(1); n <= seqlen; n++ {
 		f := utils.Factors(n)
 		a[n-1] = utils.Sum(f[:len(f)-1])
 	}
-	return a, 1
+	return a, 1, nil
+}
+
+// termA001065 computes the single term a(n) = sigma(n) - n
+func termA001065(n int64) (*bint, error) {
+	return inew(utils.Sum(utils.Factors(n)) - n), nil
 }
 
 /**
@@ -33,13 +85,16 @@ func A001065(seqlen int64) ([]int64, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A001223
  */
-func A001223(seqlen int64) ([]int64, int64) {
+func A001223(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	a40, _ := A000040(seqlen + 1)
+	a40, _, err := A000040(seqlen + 1)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = a40[n+1] - a40[n]
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -47,14 +102,17 @@ func A001223(seqlen int64) ([]int64, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A001622
  */
-func A001611(seqlen int64) ([]*bint, int64) {
+func A001611(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
-	fib, _ := A000045(seqlen)
+	fib, _, err := A000045(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = add(fib[n], inew(1))
 	}
 
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -63,12 +121,22 @@ func A001611(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A001622
  */
-func A001622(seqlen int64) ([]int64, int64) {
-	if seqlen > 1 {
-		utils.PrintWarning("note: A001622 computes the decimal expansion of the golden ratio.\nThis will not be a sequence, just the value itself")
+func A001622(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A001622", seqlen, 1); err != nil {
+		return nil, 0, err
 	}
-	fmt.Println(fdiv(fadd(fnew(1), fsqrt(fnew(5))), fnew(2)))
-	return nil, 1
+
+	// floor(phi * 10^(seqlen-1)) = floor((10^(seqlen-1) + sqrt(5 * 10^(2*(seqlen-1)))) / 2),
+	// which is exact with integer square roots
+	p := pow(inew(10), inew(seqlen-1))
+	digits := add(p, sqrt(mul(inew(5), mul(p, p))))
+	digits.Rsh(digits, 1)
+
+	a := make([]int64, seqlen)
+	for i, c := range digits.String() {
+		a[i] = int64(c - '0')
+	}
+	return a, 1, nil
 }
 
 /**
@@ -76,12 +144,11 @@ func A001622(seqlen int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A001840
  */
-func A001840(seqlen int64) ([]int64, int64) {
-	a := make([]int64, seqlen)
-	for n := int64(0); n < seqlen; n++ {
-		a[n] = int64(math.Floor(float64((n + 1)) * float64(n+2) / 6.0))
-	}
-	return a, 0
+func A001840(seqlen int64) ([]int64, int64, error) {
+	// x / ((1-x)^2 (1-x^3))
+	den := utils.NewSeries(seqlen, 1, -1).Pow(2).Mul(utils.NewSeries(seqlen, 1, 0, 0, -1))
+	a, err := utils.NewSeries(seqlen, 0, 1).Div(den).Ordinary()
+	return utils.ToIntSlice(a), 0, err
 }
 
 /**
@@ -89,12 +156,12 @@ func A001840(seqlen int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A002061
  */
-func A002061(seqlen int64) ([]int64, int64) {
+func A002061(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = int64(math.Pow(float64(n), 2.0)) - n + 1
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -102,31 +169,20 @@ func A002061(seqlen int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A002386
  */
-func A002386(seqlen int64) ([]int64, int64) {
-	utils.LongCalculationWarning("A002386")
-
-	// init
-	getPrimeCount := func(n int64) int64 {
-		return int64(1.5 * math.Exp(0.65*float64(n)))
+func A002386(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A002386", seqlen, 1); err != nil {
+		return nil, 0, err
 	}
-	a := make([]int64, seqlen)
-	primes := utils.Primes(getPrimeCount(seqlen))
-	a[0] = 2
-
-	// loop
-	gap := int64(0)
-	prev := int64(1)
-	pidx := 0
-	for i := int64(1); i < seqlen; {
-		gap = primes[pidx+1] - primes[pidx]
-		if gap > prev {
-			a[i] = primes[pidx]
-			i++
-			prev = gap
-		}
-		pidx++
+	a, err := collectInt(streamA002386, seqlen)
+	if err != nil {
+		return nil, 0, err
 	}
-	return a, 1
+	return a, 1, nil
+}
+
+// streamA002386 yields the lower ends of the record prime gaps as they are found
+func streamA002386(ctx context.Context, seqlen int64, yield Yield) error {
+	return streamPrimeGaps(ctx, seqlen, false, yield)
 }
 
 /**
@@ -134,13 +190,56 @@ func A002386(seqlen int64) ([]int64, int64) {
  * Date		December 10, 2021	Confirmed working: December 10, 2021
  * Link		https://oeis.org/A003048
  */
-func A003048(seqlen int64) ([]*bint, int64) {
-	a := iSlice(seqlen)
-	a[0] = inew(1)
-	for i := int64(1); i < seqlen; i++ {
-		a[i] = sub(mul(inew(i), a[i-1]), pow(inew(-1), inew(i)))
+func A003048(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A003048", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
+	a, err := holA003048.Terms(seqlen)
+	return a, 0, err
+}
+
+/**
+ * A006880 computes the number of primes < 10^n, i.e. pi(10^n)
+ * Date		2026.10.17
+ * Link		https://oeis.org/A006880
+ */
+func A006880(seqlen int64) ([]int64, int64, error) {
+	return primePiPowers("A006880", 10, seqlen, OVERFLOW_A006880)
+}
+
+/**
+ * A007053 computes the number of primes <= 2^n, i.e. pi(2^n)
+ * Date		2026.10.17
+ * Link		https://oeis.org/A007053
+ */
+func A007053(seqlen int64) ([]int64, int64, error) {
+	return primePiPowers("A007053", 2, seqlen, OVERFLOW_A007053)
+}
+
+// computes pi(base^n) for n = 0..seqlen-1; base^n must fit in an int64, so
+// seqlen is at most max. 10^12 takes a few seconds, and each power of 10
+// about five times as long as the one before (see primePiWarning).
+func primePiPowers(seqid string, base, seqlen, max int64) ([]int64, int64, error) {
+	if seqlen > max {
+		return nil, 0, &utils.OverflowError{Seq: seqid, Max: max}
+	}
+	a := make([]int64, seqlen)
+	x := int64(1)
+	for n := int64(0); n < seqlen; n++ {
+		a[n] = utils.PrimePi(x)
+		x *= base
+	}
+	return a, 0, nil
+}
+
+// warns when primePiPowers will count the primes past 10^12
+func primePiWarning(seqid string, base int64) DiagFunc {
+	return func(seqlen int64) []utils.Diagnostic {
+		if math.Pow(float64(base), float64(seqlen-1)) <= 1e12 {
+			return nil
+		}
+		return []utils.Diagnostic{utils.LongCalculationWarning(seqid)}
 	}
-	return a, 0
 }
 
 /**
@@ -149,26 +248,8 @@ func A003048(seqlen int64) ([]*bint, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A007947
  */
-func A007947(max int64) ([]int64, int64) {
-	a := make([]int64, 0)
-	for i := int64(0); i < max; i++ {
-		// calculate the prime factorization of i
-		pfact := utils.PrimeFactorization(i)
-
-		// strip all non unique elements
-		set := make(map[int64]bool)
-		for j := int64(0); j < int64(len(pfact)); j++ {
-			set[int64(pfact[j])] = true
-		}
-
-		// get the radical
-		radical := int64(1)
-		for key := range set {
-			radical *= key
-		}
-		a = append(a, radical)
-	}
-	return a, 1
+func A007947(max int64) ([]int64, int64, error) {
+	return utils.Radical.Table(max)[1:], 1, nil
 }
 
 /**
@@ -176,12 +257,12 @@ func A007947(max int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A011848
  */
-func A011848(seqlen int64) ([]int64, int64) {
+func A011848(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(2); n < seqlen; n++ {
 		a[n] = int64(math.Floor(float64(utils.Binomial(n, 2)) / 2.0))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -189,12 +270,12 @@ func A011848(seqlen int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A011858
  */
-func A011858(seqlen int64) ([]int64, int64) {
+func A011858(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = int64(math.Floor(float64(n*(n-1)) / 5.0))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -202,12 +283,12 @@ func A011858(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021	Confirmed working: December 12, 2021
  * Link		https://oeis.org/A027641
  */
-func A027641(seqlen int64) ([]*bint, int64) {
+func A027641(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = utils.Bernoulli(i).Num()
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -215,12 +296,12 @@ func A027641(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021	Confirmed working: December
  * Link		https://oeis.org/A027642
  */
-func A027642(seqlen int64) ([]*bint, int64) {
+func A027642(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = utils.Bernoulli(i).Denom()
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -229,37 +310,27 @@ func A027642(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A032346
  */
-func A032346(seqlen int64) ([]int64, int64) {
-	// init
-	a := make([]int64, seqlen) // the seq
-	a[0] = 1
-	old := make([]int64, seqlen) // last row
-	new := make([]int64, seqlen) // new row
-	old[0] = 1
-
-	// compute each row & store into a
-	row, col := int64(1), int64(0)
-	for ; row < seqlen; row++ {
-		col = 0
-
-		// calculate new row
-		for ; col < row; col++ {
-			new[col+1] = new[col] + old[col]
-		}
-
-		// copy down
-		if col > 0 {
-			for i := int64(0); i < col+1; i++ {
-				old[i] = new[i]
-				new[i] = 0 // erase new row
+func A032346(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A032346", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
+	// a triangle like Bell's: each row starts with the last term of the row
+	// before, and each entry adds the one above it. a(n) ends row n.
+	a := iSlice(seqlen)
+	a[0] = inew(1)
+	row := []*bint{inew(0), inew(1)}
+	for n := int64(1); n < seqlen; n++ {
+		if n > 1 {
+			next := make([]*bint, n+1)
+			next[0] = row[n-1]
+			for k := int64(0); k < n; k++ {
+				next[k+1] = add(next[k], row[k])
 			}
+			row = next
 		}
-
-		// copy the last element
-		new[0] = old[col] // overwrite first elem
-		a[row] = old[col] // copy last elem of old
+		a[n] = row[n]
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -267,13 +338,16 @@ func A032346(seqlen int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A038040
  */
-func A038040(seqlen int64) ([]int64, int64) {
+func A038040(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	d, _ := A000005(seqlen)
+	d, _, err := A000005(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = (n + 1) * d[n]
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -281,17 +355,10 @@ func A038040(seqlen int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A052614
  */
-func A052614(seqlen int64) ([]*bint, int64) {
-	a := iSlice(seqlen)
-	for n := int64(0); n < seqlen; n++ {
-		nf := float64(n)
-		sum := fnew(0)
-		for k := float64(0); k <= nf/4.0; k++ {
-			sum = fadd(sum, fnew(math.Exp(-1.0/4.0)))
-		}
-		a[n] = floor(fmul(itof(fact(inew(n))), sum))
-	}
-	return a, 0
+func A052614(seqlen int64) ([]*bint, int64, error) {
+	den := utils.NewSeries(seqlen, 1, -1).Mul(utils.NewSeries(seqlen, 1, 0, 0, 0, -1))
+	a, err := den.Inverse().Exponential()
+	return a, 0, err
 }
 
 /**
@@ -299,12 +366,12 @@ func A052614(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A088218
  */
-func A088218(seqlen int64) ([]*bint, int64) {
+func A088218(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = div(fact(inew(2*i)), mul(fact(inew(i)), fact(inew(i+1))))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -312,12 +379,12 @@ func A088218(seqlen int64) ([]*bint, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A128422
  */
-func A128422(seqlen int64) ([]int64, int64) {
+func A128422(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = n * (n - 1) / 3
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -325,7 +392,10 @@ func A128422(seqlen int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A132269
  */
-func A132269(seqlen int64) ([]int64, int64) {
+func A132269(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A132269", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := make([]int64, seqlen)
 	a[0] = 1
 	for n := int64(1); n < seqlen; n++ {
@@ -333,7 +403,7 @@ func A132269(seqlen int64) ([]int64, int64) {
 			a[n] += a[n/2]
 		}
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -341,11 +411,16 @@ func A132269(seqlen int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A164514
  */
-func A164514(seqlen int64) ([]int64, int64) {
-	a37, _ := A000037(seqlen)
-	a := utils.Shift(a37, 1)
-	a[0] = 1
-	return a, 1
+func A164514(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A164514", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
+	a37, _, err := A000037(seqlen - 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	a := append([]int64{1}, a37...)
+	return a, 1, nil
 }
 
 /**
@@ -354,11 +429,14 @@ func A164514(seqlen int64) ([]int64, int64) {
  * Date		December 16, 2021
  * Link		https://oeis.org/A168014
  */
-func A168014(seqlen int64) ([]int64, int64) {
+func A168014(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	a5, _ := A000005(seqlen)
+	a5, _, err := A000005(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(1); n < seqlen; n++ {
 		a[n] = n * (a5[n-1] - 1)
 	}
-	return a, 0
+	return a, 0, nil
 }
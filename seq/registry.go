@@ -0,0 +1,301 @@
+// ============================================================================
+// = registry.go
+// = 	Description		Registry of every programmed sequence, keyed by ID.
+// = 	Note			Each thru*.go file registers its sequences in init()
+// = 	Date			2026.10.17
+// ============================================================================
+
+package seq
+
+import (
+	"OEIS/utils"
+	"errors"
+	"sort"
+	"strconv"
+)
+
+// ########################### SEQUENCE KINDS ###############################
+// ### every sequence function returns either []int64 or []*big.Int
+
+// Kind is the type of terms returned by a sequence function
+type Kind int
+
+const (
+	IntKind Kind = iota // the sequence returns []int64
+	BigKind             // the sequence returns []*big.Int
+)
+
+// String returns a human-readable name of the kind
+func (k Kind) String() string {
+	if k == BigKind {
+		return "big.Int"
+	}
+	return "int64"
+}
+
+// MarshalText lets the kind appear by name in JSON
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// IntFunc is the signature of a sequence that returns []int64
+type IntFunc func(seqlen int64) ([]int64, int64, error)
+
+// BigFunc is the signature of a sequence that returns []*big.Int
+type BigFunc func(seqlen int64) ([]*bint, int64, error)
+
+// TermFunc computes the single term a(n), where n >= the sequence's offset
+type TermFunc func(n int64) (*bint, error)
+
+// DiagFunc returns the diagnostics (long calculations, inaccuracy, ...) that
+// apply to computing the first seqlen terms of a sequence
+type DiagFunc func(seqlen int64) []utils.Diagnostic
+
+// ############################## SEQUENCES #################################
+
+// Sequence is a registered OEIS sequence. Whatever type the underlying
+// function returns, terms are always handed out as *big.Int.
+type Sequence interface {
+	ID() string                          // the OEIS A-number, e.g. "A000045"
+	Offset() int64                       // the index of the first term
+	Kind() Kind                          // the type the underlying function computes with
+	Terms(seqlen int64) ([]*bint, error) // the first seqlen terms
+	Meta() Metadata                      // the name, keywords, etc., see metadata.go
+}
+
+// entry is the Sequence stored in the registry for each A-function
+type entry struct {
+	id      string
+	kind    Kind
+	offset  int64
+	intf    IntFunc    // set when kind == IntKind
+	bigf    BigFunc    // set when kind == BigKind
+	termf   TermFunc   // set when a(n) can be computed directly
+	streamf StreamFunc // set when the terms are searched for, see stream.go
+	diagf   DiagFunc   // set when computing the sequence deserves a warning
+}
+
+func (e *entry) ID() string    { return e.id }
+func (e *entry) Offset() int64 { return e.offset }
+func (e *entry) Kind() Kind    { return e.kind }
+
+// Terms computes the first seqlen terms, converting int64 results to *big.Int
+func (e *entry) Terms(seqlen int64) ([]*bint, error) {
+	if seqlen <= 0 {
+		return nil, &utils.PositiveError{Seq: e.id}
+	}
+	if e.kind == IntKind {
+		a, _, err := e.intf(seqlen)
+		if err != nil {
+			return nil, err
+		}
+		return utils.ToBigSlice(a), nil
+	}
+	a, _, err := e.bigf(seqlen)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// TermSequence is a Sequence that can compute a single term a(n) without
+// generating every term before it (closed forms, fast doubling, etc.)
+type TermSequence interface {
+	Sequence
+	Term(n int64) (*bint, error)
+}
+
+// termEntry is the TermSequence handed out for entries with a TermFunc
+type termEntry struct {
+	*entry
+}
+
+// Term computes a(n) with the registered TermFunc
+func (e termEntry) Term(n int64) (*bint, error) {
+	if n < e.offset {
+		return nil, belowOffset(e, n, n)
+	}
+	return e.termf(n)
+}
+
+// Term computes the single term a(n) of s. Sequences that implement
+// TermSequence compute it directly; for the rest, the terms up to a(n) are
+// generated and the last one is returned.
+func Term(s Sequence, n int64) (*bint, error) {
+	if ts, ok := s.(TermSequence); ok {
+		return ts.Term(n)
+	}
+	if n < s.Offset() {
+		return nil, belowOffset(s, n, n)
+	}
+	a, err := prefix(s, n, n)
+	if err != nil {
+		return nil, err
+	}
+	return a[0], nil
+}
+
+// Range computes the terms a(from), ..., a(to) of s. Sequences that implement
+// TermSequence compute only those terms; the rest generate every term up to
+// a(to) and drop the ones before a(from).
+func Range(s Sequence, from, to int64) ([]*bint, error) {
+	if from < s.Offset() {
+		return nil, belowOffset(s, from, to)
+	}
+	if to < from {
+		return nil, &utils.RangeError{Seq: s.ID(), From: from, To: to, Reason: "the range is empty"}
+	}
+
+	ts, ok := s.(TermSequence)
+	if !ok {
+		return prefix(s, from, to)
+	}
+	a := make([]*bint, 0, to-from+1)
+	for n := from; n <= to; n++ {
+		term, err := ts.Term(n)
+		if err != nil {
+			return nil, err
+		}
+		a = append(a, term)
+	}
+	return a, nil
+}
+
+// generates every term of s up to a(to) and returns a(from), ..., a(to).
+// from must be at least the offset of s.
+func prefix(s Sequence, from, to int64) ([]*bint, error) {
+	// some sequences hard code their first few terms, and need more of them
+	count := to - s.Offset() + 1
+	a, err := s.Terms(count)
+	var small *utils.TooSmallError
+	if errors.As(err, &small) && small.Min > count {
+		a, err = s.Terms(small.Min)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if count > int64(len(a)) {
+		return nil, &utils.RangeError{Seq: s.ID(), From: from, To: to, Reason: "the sequence only computed " + strconv.Itoa(len(a)) + " terms"}
+	}
+	return a[from-s.Offset() : count], nil
+}
+
+// the error for terms requested below the offset of s
+func belowOffset(s Sequence, from, to int64) error {
+	return &utils.RangeError{Seq: s.ID(), From: from, To: to, Reason: "the sequence starts at n = " + strconv.FormatInt(s.Offset(), 10)}
+}
+
+// ############################## REGISTRY ##################################
+
+// every registered sequence, keyed by ID
+var registry = map[string]*entry{}
+
+// registers a sequence that returns []int64
+func registerInt(id string, offset int64, f IntFunc) {
+	register(&entry{id: id, kind: IntKind, offset: offset, intf: f})
+}
+
+// registers a sequence that returns []*big.Int
+func registerBig(id string, offset int64, f BigFunc) {
+	register(&entry{id: id, kind: BigKind, offset: offset, bigf: f})
+}
+
+// attaches a TermFunc to a sequence that is already registered
+func registerTerm(id string, f TermFunc) {
+	e, ok := registry[id]
+	if !ok {
+		panic("seq: term function for unregistered sequence " + id)
+	}
+	e.termf = f
+}
+
+// attaches a DiagFunc to a sequence that is already registered
+func registerDiagnostics(id string, f DiagFunc) {
+	e, ok := registry[id]
+	if !ok {
+		panic("seq: diagnostics for unregistered sequence " + id)
+	}
+	e.diagf = f
+}
+
+// a DiagFunc that returns ds however many terms are computed
+func always(ds ...utils.Diagnostic) DiagFunc {
+	return func(int64) []utils.Diagnostic { return ds }
+}
+
+// Diagnostics returns the diagnostics that apply to computing the first
+// seqlen terms of s. Stream emits them to the sink carried by its context;
+// callers of Terms emit (or ignore) them themselves.
+func Diagnostics(s Sequence, seqlen int64) []utils.Diagnostic {
+	if e, ok := s.(diagnoser); ok && e.diagFunc() != nil {
+		return e.diagFunc()(seqlen)
+	}
+	return nil
+}
+
+// diagnoser is implemented by registry entries, which may have a DiagFunc
+type diagnoser interface {
+	diagFunc() DiagFunc
+}
+
+func (e *entry) diagFunc() DiagFunc { return e.diagf }
+
+// adds e to the registry. Registering the same ID twice is a programming
+// error, so this panics during init rather than silently overwriting.
+func register(e *entry) {
+	if _, exists := registry[e.id]; exists {
+		panic("seq: sequence " + e.id + " registered twice")
+	}
+	registry[e.id] = e
+}
+
+// Lookup returns the sequence registered under id (e.g. "A000045")
+func Lookup(id string) (Sequence, bool) {
+	e, ok := registry[id]
+	if !ok {
+		return nil, false
+	}
+	return e.sequence(), true
+}
+
+// returns e as a TermSequence if it has a TermFunc, otherwise as a Sequence
+func (e *entry) sequence() Sequence {
+	if e.termf != nil {
+		return termEntry{e}
+	}
+	return e
+}
+
+// IDs returns the IDs of every registered sequence, in ascending order
+func IDs() []string {
+	ids := make([]string, 0, len(registry))
+	for id := range registry {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// All returns every registered sequence, in ascending order of ID
+func All() []Sequence {
+	ids := IDs()
+	all := make([]Sequence, len(ids))
+	for i, id := range ids {
+		all[i] = registry[id].sequence()
+	}
+	return all
+}
+
+// ############################### HELPERS ##################################
+
+// checks that seqlen is positive and at least min, the number of terms the
+// sequence hard codes. Sequences call this before indexing into their slices.
+func checkLen(seqid string, seqlen, min int64) error {
+	if seqlen <= 0 {
+		return &utils.PositiveError{Seq: seqid}
+	}
+	if seqlen < min {
+		return &utils.TooSmallError{Seq: seqid, Min: min}
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package seq
+
+import (
+	"OEIS/utils"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// matches the name of a sequence function, e.g. A000045
+var seqName = regexp.MustCompile(`^A\d{6}$`)
+
+// TestRegistryComplete checks that every A-function in the package is
+// registered exactly once, under its own ID, and with the offset it returns.
+func TestRegistryComplete(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defined := map[string]bool{}
+	registered := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				switch v := n.(type) {
+				case *ast.FuncDecl:
+					if v.Recv == nil && seqName.MatchString(v.Name.Name) {
+						defined[v.Name.Name] = true
+					}
+				case *ast.CallExpr:
+					fn, ok := v.Fun.(*ast.Ident)
+					if !ok || !strings.HasPrefix(fn.Name, "register") || len(v.Args) < 3 {
+						return true
+					}
+					lit, ok1 := v.Args[0].(*ast.BasicLit)
+					ref, ok2 := v.Args[2].(*ast.Ident)
+					if !ok1 || !ok2 {
+						return true
+					}
+					id, _ := strconv.Unquote(lit.Value)
+					if id != ref.Name {
+						t.Errorf("%s: %s registered under ID %s", fset.Position(v.Pos()), ref.Name, id)
+					}
+					registered[ref.Name] = true
+				}
+				return true
+			})
+		}
+	}
+
+	for name := range defined {
+		if !registered[name] {
+			t.Errorf("%s is defined but never registered", name)
+		}
+		if _, ok := Lookup(name); !ok {
+			t.Errorf("%s is missing from the registry", name)
+		}
+	}
+	if len(IDs()) != len(defined) {
+		t.Errorf("registry has %d sequences, package defines %d", len(IDs()), len(defined))
+	}
+
+	// the fewest terms each function accepts are enough to see its offset
+	for _, id := range IDs() {
+		e := registry[id]
+		_, offset, err := generate(e, 1)
+		var small *utils.TooSmallError
+		if errors.As(err, &small) {
+			_, offset, err = generate(e, small.Min)
+		}
+		if err != nil {
+			t.Errorf("%s: %v", id, err)
+		} else if offset != e.offset {
+			t.Errorf("%s returns offset %d, registered with %d", id, offset, e.offset)
+		}
+	}
+}
+
+// TestTermMatchesTerms checks every direct a(n) against the generated prefix
+func TestTermMatchesTerms(t *testing.T) {
+	const count = 40
+	for _, s := range All() {
+		ts, ok := s.(TermSequence)
+		if !ok {
+			continue
+		}
+		a, err := s.Terms(count)
+		if err != nil {
+			t.Fatalf("%s: %v", s.ID(), err)
+		}
+		for i, want := range a {
+			n := s.Offset() + int64(i)
+			got, err := ts.Term(n)
+			if err != nil {
+				t.Fatalf("%s: a(%d): %v", s.ID(), n, err)
+			}
+			if got.Cmp(want) != 0 {
+				t.Errorf("%s: a(%d) = %v, want %v", s.ID(), n, got, want)
+			}
+		}
+	}
+}
+
+// TestTermLargeN checks direct a(n) past the first terms, where they no
+// longer fit in an int64
+func TestTermLargeN(t *testing.T) {
+	tests := []struct {
+		id   string
+		n    int64
+		want string
+	}{
+		{"A000217", 4294967295, "9223372034707292160"}, // the last one that fits an int64
+		{"A000217", 4294967296, "9223372039002259456"},
+		{"A000217", 10000000000, "50000000005000000000"},
+		{"A000290", 3037000499, "9223372030926249001"}, // the last one that fits an int64
+		{"A000290", 3037000500, "9223372037000250000"},
+		{"A000290", 4294967296, "18446744073709551616"},
+		{"A000290", 10000000000, "100000000000000000000"},
+		{"A000079", 100, "1267650600228229401496703205376"},
+		{"A000045", 100, "354224848179261915075"},
+	}
+	for _, tt := range tests {
+		s, _ := Lookup(tt.id)
+		got, err := s.(TermSequence).Term(tt.n)
+		if err != nil {
+			t.Fatalf("%s: a(%d): %v", tt.id, tt.n, err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("%s: a(%d) = %v, want %s", tt.id, tt.n, got, tt.want)
+		}
+	}
+}
+
+// TestTermFallback checks a(n) for sequences without a direct term function
+func TestTermFallback(t *testing.T) {
+	tests := []struct {
+		id   string
+		n    int64
+		want int64
+	}{
+		{"A000108", 10, 16796}, // Catalan numbers
+		{"A000040", 1, 2},      // primes, offset 1
+		{"A000102", 5, 2},      // hard codes its first 7 terms
+	}
+	for _, tt := range tests {
+		s, _ := Lookup(tt.id)
+		if _, ok := s.(TermSequence); ok {
+			t.Fatalf("%s has a direct term function; pick another sequence", tt.id)
+		}
+		got, err := Term(s, tt.n)
+		if err != nil {
+			t.Fatalf("%s: a(%d): %v", tt.id, tt.n, err)
+		}
+		if got.Int64() != tt.want {
+			t.Errorf("%s: a(%d) = %v, want %d", tt.id, tt.n, got, tt.want)
+		}
+	}
+
+	s, _ := Lookup("A000040")
+	var rerr *utils.RangeError
+	if _, err := Term(s, 0); !errors.As(err, &rerr) {
+		t.Errorf("A000040: a(0) returned %v, want a RangeError", err)
+	}
+}
+
+// TestRange checks ranges computed directly and from the generated prefix
+func TestRange(t *testing.T) {
+	for _, id := range []string{"A000010", "A000108", "A000102"} {
+		s, _ := Lookup(id)
+		a, err := s.Terms(30)
+		if err != nil {
+			t.Fatalf("%s: %v", id, err)
+		}
+		from := s.Offset() + 3
+		got, err := Range(s, from, from+20)
+		if err != nil {
+			t.Fatalf("%s: %v", id, err)
+		}
+		if len(got) != 21 {
+			t.Fatalf("%s: got %d terms, want 21", id, len(got))
+		}
+		for i := range got {
+			if got[i].Cmp(a[i+3]) != 0 {
+				t.Errorf("%s: a(%d) = %v, want %v", id, from+int64(i), got[i], a[i+3])
+			}
+		}
+	}
+
+	s, _ := Lookup("A000010")
+	var rerr *utils.RangeError
+	if _, err := Range(s, 0, 10); !errors.As(err, &rerr) {
+		t.Errorf("A000010: range 0..10 returned %v, want a RangeError", err)
+	}
+	if _, err := Range(s, 10, 9); !errors.As(err, &rerr) {
+		t.Errorf("A000010: range 10..9 returned %v, want a RangeError", err)
+	}
+}
+
+// TestOverflowBounds checks that sequences computed in floating point stop
+// with an OverflowError where they would lose exactness, rather than return
+// wrong terms or panic
+func TestOverflowBounds(t *testing.T) {
+	tests := []struct {
+		id  string
+		max int64
+	}{
+		{"A000150", OVERFLOW_A000150},
+		{"A000184", OVERFLOW_A000184},
+	}
+	for _, tt := range tests {
+		s, _ := Lookup(tt.id)
+		if _, err := s.Terms(tt.max); err != nil {
+			t.Errorf("%s: %d terms: %v", tt.id, tt.max, err)
+		}
+		for _, seqlen := range []int64{tt.max + 1, 200} {
+			var oerr *utils.OverflowError
+			if _, err := s.Terms(seqlen); !errors.As(err, &oerr) || oerr.Max != tt.max {
+				t.Errorf("%s: %d terms: got %v, want an OverflowError with Max %d", tt.id, seqlen, err, tt.max)
+			}
+		}
+	}
+}
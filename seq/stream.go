@@ -0,0 +1,154 @@
+// ============================================================================
+// = stream.go
+// = 	Description		Streams the terms of a sequence as they are found
+// = 	Note			Honors context.Context cancellation and deadlines
+// = 	Date			2026.10.17
+// ============================================================================
+
+package seq
+
+import (
+	"OEIS/utils"
+	"context"
+)
+
+// ############################### STREAMING ################################
+// ### search sequences (Mersenne exponents, record gaps, ...) can run for a
+// ### very long time. They stream their terms so the caller sees every term
+// ### found before a deadline.
+
+// Yield receives the term a(n). Returning false stops the stream.
+type Yield func(n int64, v *bint) bool
+
+// StreamFunc yields the first seqlen terms of a sequence in order. It stops
+// early, returning ctx.Err(), when ctx is done.
+type StreamFunc func(ctx context.Context, seqlen int64, yield Yield) error
+
+// attaches a StreamFunc to a sequence that is already registered
+func registerStream(id string, f StreamFunc) {
+	e, ok := registry[id]
+	if !ok {
+		panic("seq: stream function for unregistered sequence " + id)
+	}
+	e.streamf = f
+}
+
+// Stream yields the first seqlen terms of s in order as they are computed.
+// It returns ctx.Err() if ctx is done before every term was yielded, and nil
+// if yield stopped the stream. The Diagnostics of s go to the sink carried by
+// ctx, see utils.WithSink.
+//
+// Sequences with a StreamFunc check ctx while they search, and sequences with
+// a TermFunc check it between terms. Any other sequence is generated in one
+// piece, so its terms are only yielded if it finishes before ctx is done.
+func Stream(ctx context.Context, s Sequence, seqlen int64, yield Yield) error {
+	if seqlen <= 0 {
+		return &utils.PositiveError{Seq: s.ID()}
+	}
+	for _, d := range Diagnostics(s, seqlen) {
+		utils.Emit(ctx, d)
+	}
+	if e, ok := s.(streamer); ok && e.streamFunc() != nil {
+		return e.streamFunc()(ctx, seqlen, yield)
+	}
+
+	if ts, ok := s.(TermSequence); ok {
+		for n := s.Offset(); n < s.Offset()+seqlen; n++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			term, err := ts.Term(n)
+			if err != nil {
+				return err
+			}
+			if !yield(n, term) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	// generate the prefix in the background; it cannot be interrupted, but
+	// the caller does not have to wait for it past the deadline
+	type result struct {
+		a   []*bint
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil { // nobody could recover it past the deadline
+				done <- result{nil, panicError(s, r)}
+			}
+		}()
+		a, err := prefix(s, s.Offset(), s.Offset()+seqlen-1)
+		done <- result{a, err}
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case r := <-done:
+		if r.err != nil {
+			return r.err
+		}
+		for i, v := range r.a {
+			if !yield(s.Offset()+int64(i), v) {
+				return nil
+			}
+		}
+		return nil
+	}
+}
+
+// streamer is implemented by registry entries, which may have a StreamFunc
+type streamer interface {
+	streamFunc() StreamFunc
+}
+
+func (e *entry) streamFunc() StreamFunc { return e.streamf }
+
+// collects the terms of a StreamFunc that computes int64 terms
+func collectInt(f StreamFunc, seqlen int64) ([]int64, error) {
+	a := make([]int64, 0, seqlen)
+	err := f(context.Background(), seqlen, func(n int64, v *bint) bool {
+		a = append(a, v.Int64())
+		return true
+	})
+	return a, err
+}
+
+// collects the terms of a StreamFunc that computes big.Int terms
+func collectBig(f StreamFunc, seqlen int64) ([]*bint, error) {
+	a := make([]*bint, 0, seqlen)
+	err := f(context.Background(), seqlen, func(n int64, v *bint) bool {
+		a = append(a, v)
+		return true
+	})
+	return a, err
+}
+
+// yields the primes at the lower (or upper) end of each record gap between
+// consecutive primes, i.e. A002386 (or A000101), starting from n = 1
+func streamPrimeGaps(ctx context.Context, seqlen int64, upper bool, yield Yield) error {
+	primes := utils.NewPrimeIter(3)
+	prev, record := int64(2), int64(0)
+	for i := int64(0); i < seqlen; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		p := primes.Next()
+		if p-prev > record {
+			record = p - prev
+			end := prev
+			if upper {
+				end = p
+			}
+			i++
+			if !yield(i, inew(end)) {
+				return nil
+			}
+		}
+		prev = p
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package seq
+
+import (
+	"OEIS/utils"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// TestStreamMatchesTerms checks that every kind of sequence (searched,
+// direct a(n) and generated) streams the same terms it generates
+func TestStreamMatchesTerms(t *testing.T) {
+	for _, id := range []string{"A000101", "A002386", "A000045", "A000108"} {
+		s, _ := Lookup(id)
+		want, err := s.Terms(10)
+		if err != nil {
+			t.Fatalf("%s: %v", id, err)
+		}
+
+		var got []*bint
+		next := s.Offset()
+		err = Stream(context.Background(), s, 10, func(n int64, v *bint) bool {
+			if n != next {
+				t.Errorf("%s: streamed a(%d), want a(%d)", id, n, next)
+			}
+			next++
+			got = append(got, v)
+			return true
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", id, err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("%s: streamed %d terms, want %d", id, len(got), len(want))
+		}
+		for i := range want {
+			if got[i].Cmp(want[i]) != 0 {
+				t.Errorf("%s: a(%d) = %v, want %v", id, s.Offset()+int64(i), got[i], want[i])
+			}
+		}
+	}
+}
+
+// TestStreamCancel checks that a search stops once its context is done
+func TestStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s, _ := Lookup("A000043")
+
+	count := 0
+	err := Stream(ctx, s, 1000, func(n int64, v *bint) bool {
+		count++
+		if count == 5 {
+			cancel()
+		}
+		return true
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+	if count != 5 {
+		t.Errorf("streamed %d terms after cancelling, want 5", count)
+	}
+}
+
+// TestStreamDiagnostics checks that each call's diagnostics go to the sink in
+// its own context, even when the calls run at the same time
+func TestStreamDiagnostics(t *testing.T) {
+	cases := []struct {
+		id     string
+		seqlen int64
+		want   int
+	}{
+		{"A000319", 20, 1}, // inaccurate past a(14)
+		{"A000319", 10, 0},
+		{"A000197", 3, 2}, // a note and a long calculation
+		{"A000116", 5, 1},
+		{"A000045", 10, 0},
+	}
+	got := make([]utils.Collector, len(cases))
+	var wg sync.WaitGroup
+	for i, c := range cases {
+		wg.Add(1)
+		go func(i int, id string, seqlen int64) {
+			defer wg.Done()
+			s, _ := Lookup(id)
+			ctx := utils.WithSink(context.Background(), got[i].Sink())
+			if err := Stream(ctx, s, seqlen, func(int64, *bint) bool { return true }); err != nil {
+				t.Errorf("%s: %v", id, err)
+			}
+		}(i, c.id, c.seqlen)
+	}
+	wg.Wait()
+	for i, c := range cases {
+		diags := got[i].Diagnostics()
+		if len(diags) != c.want {
+			t.Errorf("%s with seqlen %d: got %d diagnostics, want %d", c.id, c.seqlen, len(diags), c.want)
+		}
+		for _, d := range diags {
+			if d.Seq != c.id {
+				t.Errorf("%s: got a diagnostic for %s", c.id, d.Seq)
+			}
+		}
+	}
+}
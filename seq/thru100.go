@@ -8,18 +8,115 @@
 package seq
 
 import (
+	"OEIS/transform"
 	"OEIS/utils"
+	"context"
 	"math"
 	"strconv"
 )
 
+// the linear recurrences behind sequences of this file
+var (
+	lucas      = utils.LinearRecurrence{Coeffs: []int64{1, 1}, Init: []int64{2, 1}}
+	tribonacci = utils.LinearRecurrence{Coeffs: []int64{1, 1, 1}, Init: []int64{0, 0, 1}}
+	tetranacci = utils.LinearRecurrence{Coeffs: []int64{1, 1, 1, 1}, Init: []int64{0, 0, 0, 1}}
+)
+
+// registers every sequence in this file
+func init() {
+	registerInt("A000002", 1, A000002)
+	registerInt("A000004", 0, A000004)
+	registerInt("A000005", 1, A000005)
+	registerInt("A000006", 1, A000006)
+	registerInt("A000007", 0, A000007)
+	registerInt("A000008", 0, A000008)
+	registerInt("A000010", 1, A000010)
+	registerBig("A000011", 0, A000011)
+	registerInt("A000012", 0, A000012)
+	registerBig("A000013", 0, A000013)
+	registerBig("A000018", 0, A000018)
+	registerBig("A000021", 0, A000021)
+	registerBig("A000024", 0, A000024)
+	registerInt("A000027", 1, A000027)
+	registerInt("A000030", 0, A000030)
+	registerBig("A000032", 0, A000032)
+	registerInt("A000034", 0, A000034)
+	registerInt("A000035", 0, A000035)
+	registerInt("A000037", 1, A000037)
+	registerInt("A000038", 0, A000038)
+	registerInt("A000040", 1, A000040)
+	registerInt("A000041", 1, A000041)
+	registerBig("A000042", 1, A000042)
+	registerInt("A000043", 1, A000043)
+	registerBig("A000044", 0, A000044)
+	registerBig("A000045", 0, A000045)
+	registerBig("A000047", 0, A000047)
+	registerBig("A000049", 0, A000049)
+	registerBig("A000050", 0, A000050)
+	registerBig("A000051", 0, A000051)
+	registerBig("A000058", 0, A000058)
+	registerInt("A000059", 1, A000059)
+	registerInt("A000062", 1, A000062)
+	registerInt("A000064", 0, A000064)
+	registerInt("A000065", 0, A000065)
+	registerInt("A000068", 1, A000068)
+	registerInt("A000069", 1, A000069)
+	registerInt("A000070", 0, A000070)
+	registerBig("A000071", 1, A000071)
+	registerBig("A000073", 0, A000073)
+	registerBig("A000078", 0, A000078)
+	registerBig("A000079", 0, A000079)
+	registerInt("A000082", 1, A000082)
+	registerInt("A000086", 1, A000086)
+	registerInt("A000093", 0, A000093)
+	registerInt("A000094", 1, A000094)
+	registerInt("A000096", 0, A000096)
+	registerInt("A000097", 0, A000097)
+	registerInt("A000098", 0, A000098)
+	registerBig("A000100", 0, A000100)
+
+	// sequences that compute a(n) directly
+	registerTerm("A000005", termA000005)
+	registerTerm("A000010", termA000010)
+	registerTerm("A000027", termA000027)
+	registerTerm("A000032", termA000032)
+	registerTerm("A000045", termA000045)
+	registerTerm("A000073", termA000073)
+	registerTerm("A000078", termA000078)
+	registerTerm("A000079", termA000079)
+
+	// sequences that stream the terms they search for
+	registerStream("A000040", streamA000040)
+	registerStream("A000043", streamA000043)
+
+	// sequences that warn about how long they take, or how accurate they are
+	registerDiagnostics("A000008", always(utils.LongCalculationWarning("A000008")))
+	registerDiagnostics("A000018", always(utils.LongCalculationWarning("A000018")))
+	registerDiagnostics("A000021", always(utils.LongCalculationWarning("A000021")))
+	registerDiagnostics("A000024", always(utils.LongCalculationWarning("A000024")))
+	registerDiagnostics("A000041", always(utils.LongCalculationWarning("A000041")))
+	registerDiagnostics("A000043", always(utils.LongCalculationWarning("A000043")))
+	registerDiagnostics("A000044", func(seqlen int64) []utils.Diagnostic {
+		if seqlen > 12 {
+			return nil
+		}
+		return []utils.Diagnostic{utils.NoteDiagnostic("A000044", "For best results, sequence A000044 should have more than 12 elements")}
+	})
+	registerDiagnostics("A000047", always(utils.LongCalculationWarning("A000047")))
+	registerDiagnostics("A000049", always(utils.LongCalculationWarning("A000049")))
+	registerDiagnostics("A000050", always(utils.LongCalculationWarning("A000050")))
+}
+
 /**
  * A000002 returns the Kolakoski sequence, given a sequence length
  * Date		October 08, 2021
  * Link		https://oeis.org/A000002
  */
-func A000002(seqlen int64) ([]int64, int64) {
-	return utils.Kolakoski(seqlen+1, 2)[:seqlen], 1
+func A000002(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000002", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
+	return utils.Kolakoski(seqlen+1, 2)[:seqlen], 1, nil
 }
 
 /**
@@ -27,8 +124,8 @@ func A000002(seqlen int64) ([]int64, int64) {
  * Date		October 08, 2021
  * Link		https://oeis.org/A000004
  */
-func A000004(seqlen int64) ([]int64, int64) {
-	return make([]int64, seqlen), 0
+func A000004(seqlen int64) ([]int64, int64, error) {
+	return make([]int64, seqlen), 0, nil
 }
 
 /**
@@ -36,13 +133,13 @@ func A000004(seqlen int64) ([]int64, int64) {
  * Date		October 08, 2021
  * Link		https://oeis.org/A000005
  */
-func A000005(seqlen int64) ([]int64, int64) {
-	a := make([]int64, seqlen)
-	for i := int64(0); i < seqlen; i++ {
-		count := utils.GetFactorCount(i + 1)
-		a[i] = count
-	}
-	return a, 1
+func A000005(seqlen int64) ([]int64, int64, error) {
+	return utils.Tau.Table(seqlen)[1:], 1, nil
+}
+
+// termA000005 computes the single term a(n) = tau(n)
+func termA000005(n int64) (*bint, error) {
+	return inew(utils.Tau.Eval(n)), nil
 }
 
 /**
@@ -50,10 +147,10 @@ func A000005(seqlen int64) ([]int64, int64) {
  * Date		October 08, 2021
  * Link		https://oeis.org/A000006
  */
-func A000006(seqlen int64) ([]int64, int64) {
+func A000006(seqlen int64) ([]int64, int64, error) {
 	primes := utils.Primes(seqlen)
 	a := utils.Isqrtarray(primes)
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -61,10 +158,13 @@ func A000006(seqlen int64) ([]int64, int64) {
  * Date		October 08, 2021
  * Link		https://oeis.org/A000007
  */
-func A000007(seqlen int64) ([]int64, int64) {
+func A000007(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000007", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := make([]int64, seqlen)
 	a[0] = 1
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -72,9 +172,7 @@ func A000007(seqlen int64) ([]int64, int64) {
  * Date		October 08, 2021
  * Link		https://oeis.org/A000008
  */
-func A000008(seqlen int64) ([]int64, int64) {
-	utils.LongCalculationWarning("A000008")
-
+func A000008(seqlen int64) ([]int64, int64, error) {
 	denoms := []int64{1, 2, 5, 10}
 	a := make([]int64, 0)
 	coins := int64(len(denoms))
@@ -82,7 +180,7 @@ func A000008(seqlen int64) ([]int64, int64) {
 	for i := int64(0); i < seqlen; i++ {
 		a = append(a, utils.MakeChange(coins, i, denoms))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -91,12 +189,13 @@ func A000008(seqlen int64) ([]int64, int64) {
  * Date		October 08, 2021
  * Link		https://oeis.org/A000010
  */
-func A000010(seqlen int64) ([]int64, int64) {
-	a := make([]int64, seqlen)
-	for i := int64(1); i <= seqlen; i++ {
-		a[i-1] = utils.EulerTotient(i)
-	}
-	return a, 1
+func A000010(seqlen int64) ([]int64, int64, error) {
+	return utils.Totient.Table(seqlen)[1:], 1, nil
+}
+
+// termA000010 computes the single term a(n) = phi(n)
+func termA000010(n int64) (*bint, error) {
+	return inew(utils.Totient.Eval(n)), nil
 }
 
 /**
@@ -105,9 +204,15 @@ func A000010(seqlen int64) ([]int64, int64) {
  * Fixed  	2025.02.01
  * Link 	https://oeis.org/A000011
  */
-func A000011(seqlen int64) ([]*bint, int64) {
+func A000011(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000011", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	// generate euler phi
-	euler, _ := A000010(seqlen * 2)
+	euler, _, err := A000010(seqlen * 2)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	// generate a sequence
 	a := iSlice(seqlen)
@@ -136,7 +241,7 @@ func A000011(seqlen int64) ([]*bint, int64) {
 		a[n] = round(fdiv(sum, fnew(2)))
 
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -144,12 +249,12 @@ func A000011(seqlen int64) ([]*bint, int64) {
  * Date		October 08, 2021
  * Link		https://oeis.org/A000012
  */
-func A000012(seqlen int64) ([]int64, int64) {
+func A000012(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, 0)
 	for i := int64(0); i < seqlen; i++ {
 		a = append(a, 1)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -159,10 +264,16 @@ func A000012(seqlen int64) ([]int64, int64) {
  * Fix		2025.02.01
  * Link		https://oeis.org/A000013
  */
-func A000013(seqlen int64) ([]*bint, int64) {
+func A000013(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000013", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(1)
-	phi, _ := A000010(seqlen * 2)
+	phi, _, err := A000010(seqlen * 2)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(1); n < seqlen; n++ {
 		// calculate the sum
 		sum := fnew(0)
@@ -179,7 +290,7 @@ func A000013(seqlen int64) ([]*bint, int64) {
 		}
 		a[n] = round(sum)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -187,10 +298,12 @@ func A000013(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021	Confirmed working: December 12, 2021
  * Link		https://oeis.org/A000018
  */
-func A000018(seqlen int64) ([]*bint, int64) {
-	utils.LongCalculationWarning("A000018")
+func A000018(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000018", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := utils.Repr(seqlen, 1, 16, 1)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -198,10 +311,12 @@ func A000018(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021	Confirmed working: December 12, 2021
  * Link		https://oeis.org/A000021
  */
-func A000021(seqlen int64) ([]*bint, int64) {
-	utils.LongCalculationWarning("A000021")
+func A000021(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000021", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := utils.Repr(seqlen, 1, 12, 1)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -209,10 +324,12 @@ func A000021(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021	Confirmed working: December 12, 2021
  * Link		https://oeis.org/A000024
  */
-func A000024(seqlen int64) ([]*bint, int64) {
-	utils.LongCalculationWarning("A000024")
+func A000024(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000024", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := utils.Repr(seqlen, 1, 10, 1)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -220,12 +337,17 @@ func A000024(seqlen int64) ([]*bint, int64) {
  * Date		October 09, 2021
  * Link		https://oeis.org/
  */
-func A000027(seqlen int64) ([]int64, int64) {
+func A000027(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, 0)
-	for i := int64(0); i < seqlen+1; i++ {
+	for i := int64(0); i < seqlen; i++ {
 		a = append(a, i+1)
 	}
-	return a, 1
+	return a, 1, nil
+}
+
+// termA000027 computes the single term a(n) = n
+func termA000027(n int64) (*bint, error) {
+	return inew(n), nil
 }
 
 /**
@@ -233,12 +355,12 @@ func A000027(seqlen int64) ([]int64, int64) {
  * Date		October 09, 2021
  * Link		https://oeis.org/A000030
  */
-func A000030(seqlen int64) ([]int64, int64) {
+func A000030(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, 0)
 	for i := int64(0); i < seqlen; i++ {
 		a = append(a, utils.GetFirstDigit(i))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -247,14 +369,13 @@ func A000030(seqlen int64) ([]int64, int64) {
  * Date		October 09, 2021
  * Link		https://oeis.org/A000032
  */
-func A000032(seqlen int64) ([]*bint, int64) {
-	a := iSlice(seqlen)
-	a[0] = inew(2) // a(0)=2
-	a[1] = inew(1) // a(1)=1
-	for i := int64(2); i < seqlen; i++ {
-		a[i].Add(a[i-2], a[i-1])
-	}
-	return a, 0
+func A000032(seqlen int64) ([]*bint, int64, error) {
+	return lucas.Terms(seqlen), 0, nil
+}
+
+// termA000032 computes the single term a(n) = L(n) by fast doubling
+func termA000032(n int64) (*bint, error) {
+	return utils.Lucas(n), nil
 }
 
 /**
@@ -262,12 +383,12 @@ func A000032(seqlen int64) ([]*bint, int64) {
  * Date		October 08, 2021
  * Link		https://oeis.org/A000034
  */
-func A000034(seqlen int64) ([]int64, int64) {
+func A000034(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = i%2 + 1
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -275,12 +396,12 @@ func A000034(seqlen int64) ([]int64, int64) {
  * Date		October 09, 2021
  * Link		https://oeis.org/A000035
  */
-func A000035(seqlen int64) ([]int64, int64) {
+func A000035(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = i % 2
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -288,13 +409,13 @@ func A000035(seqlen int64) ([]int64, int64) {
  * Date		October 09, 2021
  * Link		https://oeis.org/A000037
  */
-func A000037(seqlen int64) ([]int64, int64) {
+func A000037(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, 0)
-	for i := int64(1); i < seqlen; i++ {
+	for i := int64(1); i <= seqlen; i++ {
 		// a(n) = n + floor(1/2 + sqrt(n))
 		a = append(a, i+int64(math.Floor(0.5+math.Sqrt(float64(i)))))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -302,28 +423,36 @@ func A000037(seqlen int64) ([]int64, int64) {
  * Date		October 09, 2021
  * Link		https://oeis.org/A000038
  */
-func A000038(seqlen int64) ([]int64, int64) {
+func A000038(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000038", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := make([]int64, seqlen)
 	a[0] = 2
-	return a, 0
+	return a, 0, nil
 }
 
 /**
- * A000040 computes prime numbers using Golang's built-in
+ * A000040 computes prime numbers with a segmented sieve
  * Date		October 09, 2021
  * Link		https://oeis.org/A000040
  */
-func A000040(seqlen int64) ([]int64, int64) {
-	a := make([]int64, 0)
-	prime := int64(0)
-	for i := int64(0); i < seqlen; {
-		if utils.IsPrime(prime) {
-			a = append(a, prime)
-			i++
+func A000040(seqlen int64) ([]int64, int64, error) {
+	return utils.Primes(seqlen), 1, nil
+}
+
+// streamA000040 yields the primes as the sieve finds them
+func streamA000040(ctx context.Context, seqlen int64, yield Yield) error {
+	it := utils.NewPrimeIter(2)
+	for n := int64(1); n <= seqlen; n++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !yield(n, inew(it.Next())) {
+			return nil
 		}
-		prime++
 	}
-	return a, 1
+	return nil
 }
 
 /**
@@ -331,14 +460,12 @@ func A000040(seqlen int64) ([]int64, int64) {
  * Date		October 09, 2021
  * Link		https://oeis.org/A000041
  */
-func A000041(seqlen int64) ([]int64, int64) {
-	utils.LongCalculationWarning("A000041")
-
+func A000041(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = utils.CountParts(i)
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -346,13 +473,16 @@ func A000041(seqlen int64) ([]int64, int64) {
  * Date		October 09, 2021
  * Link		https://oeis.org/A000042
  */
-func A000042(seqlen int64) ([]*bint, int64) {
+func A000042(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000042", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(1)
 	for i := int64(1); i < seqlen; i++ {
 		a[i] = add(mul(a[i-1], inew(10)), inew(1))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -360,24 +490,33 @@ func A000042(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000043
  */
-func A000043(seqlen int64) ([]int64, int64) {
-	utils.LongCalculationWarning("A000043")
+func A000043(seqlen int64) ([]int64, int64, error) {
+	a, err := collectInt(streamA000043, seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
+	return a, 1, nil
+}
 
-	a := make([]int64, seqlen)
+// streamA000043 yields the Mersenne exponents as they are found
+func streamA000043(ctx context.Context, seqlen int64, yield Yield) error {
 	prime := int64(0)
-	i := int64(0)
-	for i < seqlen {
+	for i := int64(0); i < seqlen; prime++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		//mersenne := int64(math.Pow(2, float64(prime))) - 1
 		merprime := zero()
 		merprime.Exp(inew(2), inew(prime), inew(0))
 		merprime.Sub(merprime, inew(1))
 		if merprime.ProbablyPrime(20) && utils.IsPrime(prime) {
-			a[i] = prime
 			i++
+			if !yield(i, inew(prime)) {
+				return nil
+			}
 		}
-		prime++
 	}
-	return a, 1
+	return nil
 }
 
 /**
@@ -386,9 +525,9 @@ func A000043(seqlen int64) ([]int64, int64) {
  * Date December 07, 2021
  * Link: https://oeis.org/A000044
  */
-func A000044(seqlen int64) ([]*bint, int64) {
-	if seqlen <= 12 {
-		utils.PrintWarning("For best results, sequence A000044 should have more than 12 elements")
+func A000044(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000044", seqlen, 2); err != nil {
+		return nil, 0, err
 	}
 
 	a := iSlice(seqlen + 1)
@@ -411,7 +550,7 @@ func A000044(seqlen int64) ([]*bint, int64) {
 		a[i].Sub(a[i], a[i-13])
 	}
 
-	return a, 0
+	return a[:seqlen], 0, nil
 }
 
 /**
@@ -419,9 +558,14 @@ func A000044(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000045
  */
-func A000045(seqlen int64) ([]*bint, int64) {
+func A000045(seqlen int64) ([]*bint, int64, error) {
 	a := utils.Nacci(seqlen, 2, true)
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000045 computes the single term a(n) = F(n) by fast doubling
+func termA000045(n int64) (*bint, error) {
+	return utils.Fibonacci(n), nil
 }
 
 /**
@@ -429,11 +573,13 @@ func A000045(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021	Confirmed working: December 12, 2021
  * Link		https://oeis.org/A000047
  */
-func A000047(seqlen int64) ([]*bint, int64) {
-	utils.LongCalculationWarning("A000047")
+func A000047(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000047", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 
 	a := utils.Repr(seqlen, 1, -2, 1)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -441,10 +587,12 @@ func A000047(seqlen int64) ([]*bint, int64) {
  * Date: December 12, 2021	Confirmed working: December 12, 2021
  * Link: https://oeis.org/A000049
  */
-func A000049(seqlen int64) ([]*bint, int64) {
-	utils.LongCalculationWarning("A000049")
+func A000049(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000049", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := utils.Repr(seqlen, 3, 4, 0)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -452,10 +600,12 @@ func A000049(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021	Confirmed working: December 12, 2021
  * Link		https://oeis.org/A000050
  */
-func A000050(seqlen int64) ([]*bint, int64) {
-	utils.LongCalculationWarning("A000050")
+func A000050(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000050", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := utils.Repr(seqlen, 1, 1, 1)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -463,12 +613,15 @@ func A000050(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021	Confirmed working: December 12, 2021
  * Link		https://oeis.org/A000051
  */
-func A000051(seqlen int64) ([]*bint, int64) {
-	a, _ := A000079(seqlen)
+func A000051(seqlen int64) ([]*bint, int64, error) {
+	a, _, err := A000079(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = add(a[i], inew(1))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -476,7 +629,10 @@ func A000051(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000058
  */
-func A000058(seqlen int64) ([]*bint, int64) {
+func A000058(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000058", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(2)
 	for i := int64(0); i < seqlen-1; i++ {
@@ -484,7 +640,7 @@ func A000058(seqlen int64) ([]*bint, int64) {
 		a[i+1].Sub(a[i+1], a[i])
 		a[i+1].Add(a[i+1], inew(1))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -492,7 +648,7 @@ func A000058(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000059
  */
-func A000059(seqlen int64) ([]int64, int64) {
+func A000059(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	prime := int64(0)
 	for i := int64(0); i < seqlen; {
@@ -503,7 +659,7 @@ func A000059(seqlen int64) ([]int64, int64) {
 		}
 		prime++
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -511,12 +667,12 @@ func A000059(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000062
  */
-func A000062(seqlen int64) ([]int64, int64) {
+func A000062(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(1); i <= seqlen; i++ {
 		a[i-1] = int64(math.Floor(float64(i) / (float64(math.E) - 2)))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -524,13 +680,12 @@ func A000062(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000064
  */
-func A000064(seqlen int64) ([]int64, int64) {
-	a := make([]int64, seqlen)
-	a8, _ := A000008(seqlen)
-	for i := int64(1); i <= seqlen; i++ {
-		a[i-1] = utils.Sum(a8[:i])
+func A000064(seqlen int64) ([]int64, int64, error) {
+	a8, _, err := A000008(seqlen)
+	if err != nil {
+		return nil, 0, err
 	}
-	return a, 0
+	return utils.ToIntSlice(transform.PartialSums(utils.ToBigSlice(a8))), 0, nil
 }
 
 /**
@@ -538,13 +693,16 @@ func A000064(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000065
  */
-func A000065(seqlen int64) ([]int64, int64) {
+func A000065(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	a10, _ := A000041(seqlen)
+	a10, _, err := A000041(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = a10[i] - 1
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -552,7 +710,7 @@ func A000065(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000068
  */
-func A000068(seqlen int64) ([]int64, int64) {
+func A000068(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	prime := int64(0)
 	for i := int64(0); i < seqlen; {
@@ -563,7 +721,7 @@ func A000068(seqlen int64) ([]int64, int64) {
 		}
 		prime++
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -571,7 +729,7 @@ func A000068(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000069
  */
-func A000069(seqlen int64) ([]int64, int64) {
+func A000069(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	num := int64(0)
 	for i := int64(0); i < seqlen; {
@@ -588,7 +746,7 @@ func A000069(seqlen int64) ([]int64, int64) {
 		}
 		num++
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -597,13 +755,16 @@ func A000069(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000070
  */
-func A000070(seqlen int64) ([]int64, int64) {
+func A000070(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	p, _ := A000041(seqlen)
+	p, _, err := A000041(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(1); i <= seqlen; i++ {
 		a[i-1] = utils.Sum(p[:i])
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -612,13 +773,16 @@ func A000070(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000071
  */
-func A000071(seqlen int64) ([]*bint, int64) {
+func A000071(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
-	F, _ := A000045(seqlen + 1)
+	F, _, err := A000045(seqlen + 1)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(1); i <= seqlen; i++ {
 		a[i-1].Sub(F[i], inew(1))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -627,14 +791,13 @@ func A000071(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000073
  */
-func A000073(seqlen int64) ([]*bint, int64) {
-	a := iSlice(seqlen)
-	a[0], a[1], a[2] = inew(0), inew(0), inew(1)
-	for i := int64(3); i < seqlen; i++ {
-		a[i].Add(a[i-1], a[i-2])
-		a[i].Add(a[i], a[i-3])
-	}
-	return a, 0
+func A000073(seqlen int64) ([]*bint, int64, error) {
+	return tribonacci.Terms(seqlen), 0, nil
+}
+
+// termA000073 computes the single term a(n) from the recurrence
+func termA000073(n int64) (*bint, error) {
+	return tribonacci.Term(n), nil
 }
 
 /**
@@ -643,14 +806,13 @@ func A000073(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000078
  */
-func A000078(seqlen int64) ([]*bint, int64) {
-	a := utils.InitBslice(seqlen, []*bint{inew(0), inew(0), inew(0), inew(1)})
-	for i := int64(4); i < seqlen; i++ {
-		a[i].Add(a[i-1], a[i-2])
-		a[i].Add(a[i], a[i-3])
-		a[i].Add(a[i], a[i-4])
-	}
-	return a, 0
+func A000078(seqlen int64) ([]*bint, int64, error) {
+	return tetranacci.Terms(seqlen), 0, nil
+}
+
+// termA000078 computes the single term a(n) from the recurrence
+func termA000078(n int64) (*bint, error) {
+	return tetranacci.Term(n), nil
 }
 
 /**
@@ -658,37 +820,30 @@ func A000078(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000079
  */
-func A000079(seqlen int64) ([]*bint, int64) {
+func A000079(seqlen int64) ([]*bint, int64, error) {
 	a := utils.Powers(seqlen, inew(2))
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000079 computes the single term a(n) = 2^n
+func termA000079(n int64) (*bint, error) {
+	return pow(inew(2), inew(n)), nil
 }
 
 /**
  * A000082: a(n) = n^2*Product_{p|n} (1 + 1/p)
- * Note		There may be some rounding error due to float64 <-> int64 conversions
  * Date		December 07, 2021
  * Link		https://oeis.org/A000082
  */
-func A000082(seqlen int64) ([]int64, int64) {
-	// this computes the Product_{p|n} (1 + 1/p) part
-	gen := func(num int64) float64 {
-		prod := 1.0
-		arr := utils.Factors(num)
-
-		// compute the product
-		for i := 0; i < len(arr); i++ {
-			if utils.IsPrime(arr[i]) {
-				prod *= (1 + float64(1)/float64(arr[i]))
-			}
-		}
-		return prod
-	}
+func A000082(seqlen int64) ([]int64, int64, error) {
+	// a(n) = n * psi(n), with Dedekind's psi(n) = n * Product_{p|n} (1 + 1/p)
+	psi := utils.DedekindPsi.Table(seqlen)
 
 	a := make([]int64, seqlen)
-	for i := int64(1); i <= seqlen; i++ {
-		a[i-1] = int64(math.Round(math.Pow(float64(i), 2) * gen(i)))
+	for n := int64(1); n <= seqlen; n++ {
+		a[n-1] = n * psi[n]
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -696,7 +851,7 @@ func A000082(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021	Confirmed working: December 12, 2021
  * Link		https://oeis.org/A000086
  */
-func A000086(seqlen int64) ([]int64, int64) {
+func A000086(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(1); n < seqlen; n++ {
 		// count soln's to x^2 - x + 1 == 0
@@ -709,7 +864,7 @@ func A000086(seqlen int64) ([]int64, int64) {
 		}
 		a[n-1] = count
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -717,12 +872,12 @@ func A000086(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000093
  */
-func A000093(seqlen int64) ([]int64, int64) {
+func A000093(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = int64(math.Floor(math.Pow(float64(i), 1.5)))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -731,13 +886,16 @@ func A000093(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000094
  */
-func A000094(seqlen int64) ([]int64, int64) {
+func A000094(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	a41, _ := A000041(seqlen)
+	a41, _, err := A000041(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(1); i < seqlen; i++ {
 		a[i] = a41[i] - i
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -745,12 +903,12 @@ func A000094(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000096
  */
-func A000096(seqlen int64) ([]int64, int64) {
+func A000096(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = int64(i * (i + 3.0) / 2.0)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -759,16 +917,19 @@ func A000096(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000097
  */
-func A000097(seqlen int64) ([]int64, int64) {
+func A000097(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	a70, _ := A000070(seqlen)
+	a70, _, err := A000070(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(0); i < seqlen; i++ {
 		bound := int64(math.Floor(float64(i) / 2.0))
 		for j := int64(0); j <= bound; j++ {
 			a[i] += a70[i-2*j]
 		}
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -777,16 +938,19 @@ func A000097(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000098
  */
-func A000098(seqlen int64) ([]int64, int64) {
+func A000098(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	a97, _ := A000097(seqlen)
+	a97, _, err := A000097(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(0); i < seqlen; i++ {
 		bound := int64(math.Floor(float64(i) / 3.0))
 		for j := int64(0); j <= bound; j++ {
 			a[i] += a97[i-3*j]
 		}
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -794,13 +958,19 @@ func A000098(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000100
  */
-func A000100(seqlen int64) ([]*bint, int64) {
+func A000100(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000100", seqlen, 5); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
-	Fib, _ := A000045(seqlen)
+	Fib, _, err := A000045(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	a[0], a[1], a[2] = zero(), zero(), zero()
 	a[3], a[4] = inew(1), inew(2)
 	for i := int64(5); i < seqlen; i++ {
 		a[i] = addall(Fib[i-2], a[i-3], a[i-2], a[i-1])
 	}
-	return a, 0
+	return a, 0, nil
 }
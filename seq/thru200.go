@@ -8,45 +8,121 @@
 package seq
 
 import (
+	"OEIS/transform"
 	"OEIS/utils"
+	"context"
 	"math"
 	"strconv"
 )
 
 const (
+	OVERFLOW_A000150 = 32 // the float64 gamma function is exact only that far
 	OVERFLOW_A000184 = 28 // note to future self: this is a legitimate use of Overflow
 )
 
+// the recurrences behind sequences of this file
+var (
+	// the Pell numbers, a(n) = 2a(n-1) + a(n-2)
+	pell = utils.LinearRecurrence{Coeffs: []int64{2, 1}, Init: []int64{0, 1}}
+	// a(n) = n a(n-1) + (n-2) a(n-2)
+	holA000153 = utils.NewHolonomic([][]int64{{1}, {0, 1}, {-2, 1}}, []int64{0, 1})
+	// the derangements, a(n) = (n-1) (a(n-1) + a(n-2))
+	holA000166 = utils.NewHolonomic([][]int64{{1}, {-1, 1}, {-1, 1}}, []int64{1, 0})
+	// (n-2) a(n) = (n^2-3n+3) (a(n-1) + a(n-2)) + (n-1) a(n-3), from a(4)
+	holA000179 = utils.NewHolonomic([][]int64{{-2, 1}, {3, -3, 1}, {3, -3, 1}, {-1, 1}}, []int64{1, -1, 0, 1})
+)
+
+// registers every sequence in this file
+func init() {
+	registerInt("A000101", 1, A000101)
+	registerBig("A000102", 0, A000102)
+	registerBig("A000108", 0, A000108)
+	registerBig("A000110", 0, A000110)
+	registerBig("A000111", 0, A000111)
+	registerInt("A000114", 2, A000114)
+	registerInt("A000115", 0, A000115)
+	registerBig("A000116", 0, A000116)
+	registerBig("A000117", 0, A000117)
+	registerInt("A000118", 0, A000118)
+	registerInt("A000120", 1, A000120)
+	registerInt("A000123", 0, A000123)
+	registerInt("A000124", 0, A000124)
+	registerInt("A000125", 0, A000125)
+	registerBig("A000126", 1, A000126)
+	registerInt("A000127", 1, A000127)
+	registerInt("A000128", 1, A000128)
+	registerBig("A000129", 0, A000129)
+	registerBig("A000133", 1, A000133)
+	registerBig("A000138", 0, A000138)
+	registerBig("A000139", 0, A000139)
+	registerBig("A000142", 0, A000142)
+	registerInt("A000148", 2, A000148)
+	registerBig("A000149", 0, A000149)
+	registerBig("A000150", 0, A000150)
+	registerBig("A000153", 0, A000153)
+	registerInt("A000158", 3, A000158)
+	registerInt("A000160", 4, A000160)
+	registerInt("A000161", 0, A000161)
+	registerInt("A000164", 0, A000164)
+	registerBig("A000165", 0, A000165)
+	registerBig("A000166", 0, A000166)
+	registerBig("A000168", 0, A000168)
+	registerBig("A000169", 1, A000169)
+	registerBig("A000172", 0, A000172)
+	registerInt("A000174", 0, A000174)
+	registerInt("A000177", 0, A000177)
+	registerBig("A000178", 0, A000178)
+	registerBig("A000179", 0, A000179)
+	registerBig("A000182", 1, A000182)
+	registerBig("A000184", 2, A000184)
+	registerInt("A000188", 1, A000188)
+	registerInt("A000189", 1, A000189)
+	registerInt("A000190", 1, A000190)
+	registerInt("A000193", 1, A000193)
+	registerInt("A000194", 0, A000194)
+	registerInt("A000195", 1, A000195)
+	registerInt("A000196", 0, A000196)
+	registerBig("A000197", 0, A000197)
+
+	// sequences that compute a(n) directly
+	registerTerm("A000129", termA000129)
+	registerTerm("A000142", termA000142)
+	registerTerm("A000165", termA000165)
+
+	// sequences that stream the terms they search for
+	registerStream("A000101", streamA000101)
+
+	// sequences that warn about how long they take, or how accurate they are
+	registerDiagnostics("A000101", always(utils.LongCalculationWarning("A000101")))
+	registerDiagnostics("A000111", always(utils.AccuracyWarning("A000111")))
+	registerDiagnostics("A000116", always(utils.AccuracyWarningWithLength("A000116", 0, "Calculation for sequence A000116 may be inaccurate: it is computed via A000111, the bisection of A000013.")))
+	registerDiagnostics("A000158", always(utils.LongCalculationWarning("A000158")))
+	registerDiagnostics("A000160", always(utils.LongCalculationWarning("A000160")))
+	registerDiagnostics("A000174", always(utils.LongCalculationWarning("A000174")))
+	registerDiagnostics("A000177", always(utils.LongCalculationWarning("A000177")))
+	registerDiagnostics("A000182", always(utils.LongCalculationWarning("A000182")))
+	registerDiagnostics("A000197", always(utils.NoteDiagnostic("A000197", "A000197 computes (n!)!, which gets large EXTREMELY quickly. This can crash your terminal if you choose a value too large!"), utils.LongCalculationWarning("A000197")))
+}
+
 /**
  * A000101 computes the record gaps b/w primes (upper end)
  * Date		December 07, 2021
  * Link		https://oeis.org/A000101
  */
-func A000101(seqlen int64) ([]int64, int64) {
-	utils.LongCalculationWarning("A000101")
-
-	// init
-	getPrimeCount := func(n int64) int64 {
-		return int64(1.5 * math.Exp(0.65*float64(n)))
+func A000101(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000101", seqlen, 1); err != nil {
+		return nil, 0, err
 	}
-	a := make([]int64, seqlen)
-	primes := utils.Primes(getPrimeCount(seqlen))
-	a[0] = 3
-
-	// loop
-	gap := int64(0)
-	prev := int64(1)
-	pidx := 0
-	for i := int64(1); i < seqlen; {
-		gap = primes[pidx+1] - primes[pidx]
-		if gap > prev {
-			a[i] = primes[pidx+1]
-			i++
-			prev = gap
-		}
-		pidx++
+	a, err := collectInt(streamA000101, seqlen)
+	if err != nil {
+		return nil, 0, err
 	}
-	return a, 1
+	return a, 1, nil
+}
+
+// streamA000101 yields the upper ends of the record prime gaps as they are found
+func streamA000101(ctx context.Context, seqlen int64, yield Yield) error {
+	return streamPrimeGaps(ctx, seqlen, true, yield)
 }
 
 /**
@@ -55,14 +131,17 @@ func A000101(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000102
  */
-func A000102(seqlen int64) ([]*bint, int64) {
+func A000102(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000102", seqlen, 7); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[4], a[5], a[6] = inew(1), inew(2), inew(5)
 	for i := int64(7); i < seqlen; i++ {
 		// 2 * a[i-1] + a[i-2] - 2 * a[i-4] - 3 * a[i-5] - 2 * a[i-6] - a[i-7]
 		a[i] = addall(mul(inew(2), a[i-1]), a[i-2], mul(inew(-2), a[i-4]), mul(inew(-3), a[i-5]), mul(inew(-2), a[i-6]), neg(a[i-7]))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -71,7 +150,10 @@ func A000102(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000108
  */
-func A000108(seqlen int64) ([]*bint, int64) {
+func A000108(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000108", seqlen, 2); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0], a[1] = inew(1), inew(1)
 	for i := int64(2); i < seqlen; i++ {
@@ -80,7 +162,7 @@ func A000108(seqlen int64) ([]*bint, int64) {
 			a[i] = add(a[i], temp)
 		}
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -96,7 +178,10 @@ func A000108(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000110
  */
-func A000110(seqlen int64) ([]*bint, int64) {
+func A000110(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000110", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	// init
 	a := iSlice(seqlen + 1) // the seq
 	a[0] = inew(1)
@@ -126,7 +211,7 @@ func A000110(seqlen int64) ([]*bint, int64) {
 		new[0] = old[col]   // overwrite first elem
 		a[row+1] = old[col] // copy last elem of old
 	}
-	return a, 0
+	return a[:seqlen], 0, nil
 }
 
 /**
@@ -134,9 +219,8 @@ func A000110(seqlen int64) ([]*bint, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000111
  */
-func A000111(seqlen int64) ([]*bint, int64) {
+func A000111(seqlen int64) ([]*bint, int64, error) {
 	// TODO: figure out why there are inaccuracies
-	utils.AccuracyWarning("A000111")
 
 	a := iSlice(seqlen)
 	for i := int64(1); i <= seqlen; i++ {
@@ -148,7 +232,7 @@ func A000111(seqlen int64) ([]*bint, int64) {
 		fin := fmul(prod, ifact)   // 2 * (2/pi)^i * i!
 		a[i-1] = round(fin)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -156,7 +240,10 @@ func A000111(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000114
  */
-func A000114(seqlen int64) ([]int64, int64) {
+func A000114(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000114", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := make([]int64, seqlen)
 	a[0] = 3
 	for n := int64(3); n <= seqlen+1; n++ {
@@ -168,7 +255,7 @@ func A000114(seqlen int64) ([]int64, int64) {
 		}
 		a[n-2] = int64(math.Round(b))
 	}
-	return a, 2
+	return a, 2, nil
 }
 
 /**
@@ -177,12 +264,12 @@ func A000114(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000115
  */
-func A000115(seqlen int64) ([]int64, int64) {
+func A000115(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = int64(math.Round(math.Pow(float64(i+4), 2) / 20))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -190,13 +277,16 @@ func A000115(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000116
  */
-func A000116(seqlen int64) ([]*bint, int64) {
-	// warn the user about inaccuracies
-	utils.AccuracyWarning("A000111 (which computes the bisection of A000013)")
+func A000116(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000116", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 
-	a13, _ := A000013(seqlen * 2)
-	a := utils.BisectionBig(a13)
-	return a, 0
+	a13, _, err := A000013(seqlen * 2)
+	if err != nil {
+		return nil, 0, err
+	}
+	return transform.Section(a13, 2, 0), 0, nil
 }
 
 /**
@@ -205,13 +295,19 @@ func A000116(seqlen int64) ([]*bint, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000117
  */
-func A000117(seqlen int64) ([]*bint, int64) {
+func A000117(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000117", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
-	a11, _ := A000011(seqlen * 2)
+	a11, _, err := A000011(seqlen * 2)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = a11[2*i]
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -220,7 +316,10 @@ func A000117(seqlen int64) ([]*bint, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000118
  */
-func A000118(seqlen int64) ([]int64, int64) {
+func A000118(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000118", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	// generate sigma
 	sigma := make([]int64, seqlen)
 	for i := int64(1); i < seqlen; i++ {
@@ -243,7 +342,7 @@ func A000118(seqlen int64) ([]int64, int64) {
 		a[i] = 8*sigma[i-1] - b
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -252,7 +351,7 @@ func A000118(seqlen int64) ([]int64, int64) {
  * Date		December 07, 2021
  * Link		https://oeis.org/A000120
  */
-func A000120(seqlen int64) ([]int64, int64) {
+func A000120(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		binary := strconv.FormatInt(i, 2) // convert to binary
@@ -264,7 +363,7 @@ func A000120(seqlen int64) ([]int64, int64) {
 		}
 		a[i] = count
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -272,13 +371,16 @@ func A000120(seqlen int64) ([]int64, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000123
  */
-func A000123(seqlen int64) ([]int64, int64) {
+func A000123(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000123", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := make([]int64, seqlen)
 	a[0] = 1
 	for i := int64(1); i < seqlen; i++ {
 		a[i] = a[i/2] + a[i-1]
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -287,13 +389,13 @@ func A000123(seqlen int64) ([]int64, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000124
  */
-func A000124(seqlen int64) ([]int64, int64) {
+func A000124(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = (i*(i+1))/2 + 1
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -303,12 +405,12 @@ func A000124(seqlen int64) ([]int64, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000125
  */
-func A000125(seqlen int64) ([]int64, int64) {
+func A000125(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = (int64(math.Pow(float64(i), 3)) + 5*i + 6) / 6
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -316,14 +418,17 @@ func A000125(seqlen int64) ([]int64, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000126
  */
-func A000126(seqlen int64) ([]*bint, int64) {
+func A000126(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000126", seqlen, 3); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0], a[1], a[2] = inew(1), inew(2), inew(4)
 	for i := int64(3); i < seqlen; i++ {
 		// 2 * a[i-1] - a[i-3] + 1
 		a[i] = addall(mul(inew(2), a[i-1]), neg(a[i-3]), inew(1))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -332,13 +437,13 @@ func A000126(seqlen int64) ([]*bint, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000127
  */
-func A000127(seqlen int64) ([]int64, int64) {
+func A000127(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(1); i <= seqlen; i++ {
 		j := float64(i) // conversion to float64 from int64
 		a[i-1] = (int64(math.Pow(j, 4)) - 6*int64(math.Pow(j, 3)) + 23*int64(math.Pow(j, 2)) - 18*i + 24) / 24
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -346,14 +451,17 @@ func A000127(seqlen int64) ([]int64, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000128
  */
-func A000128(seqlen int64) ([]int64, int64) {
+func A000128(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	Fib, _ := A000045(seqlen + 5)
+	Fib, _, err := A000045(seqlen + 5)
+	if err != nil {
+		return nil, 0, err
+	}
 	F := utils.ToIntSlice(Fib)
 	for i := int64(1); i <= seqlen; i++ {
 		a[i-1] = F[i+4] - i*(i+1)/2 - 3
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -361,13 +469,13 @@ func A000128(seqlen int64) ([]int64, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000129
  */
-func A000129(seqlen int64) ([]int64, int64) {
-	a := make([]int64, seqlen)
-	a[0], a[1] = 0, 1
-	for i := int64(2); i < seqlen; i++ {
-		a[i] = 2*a[i-1] + a[i-2]
-	}
-	return a, 0
+func A000129(seqlen int64) ([]*bint, int64, error) {
+	return pell.Terms(seqlen), 0, nil
+}
+
+// termA000129 computes the single term a(n) from the recurrence
+func termA000129(n int64) (*bint, error) {
+	return pell.Term(n), nil
 }
 
 /**
@@ -375,7 +483,7 @@ func A000129(seqlen int64) ([]int64, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000133
  */
-func A000133(seqlen int64) ([]*bint, int64) {
+func A000133(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		// a(n) = (2^(2^n) + (2^n-1)*2^(2^(n-1)+1))/2^(n+1)
@@ -384,7 +492,7 @@ func A000133(seqlen int64) ([]*bint, int64) {
 		frac := div(numer, pow(inew(2), inew(n+1)))
 		a[n] = add(pow(inew(2), twoN), mul(sub(twoN, inew(1)), frac))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -392,25 +500,11 @@ func A000133(seqlen int64) ([]*bint, int64) {
  * Date		December 09, 2021
  * Link		https://oeis.org/A000138
  */
-func A000138(seqlen int64) ([]*bint, int64) {
-	utils.AccuracyWarning("A000138")
-	a := iSlice(seqlen)
-	sum := fzero()
-	for n := int64(0); n < seqlen; n++ {
-		// a(n) = n! * sum i=0 ... [n/4]( (-1)^i /(i! * 4^i))
-		cap := float64(n) / 4.0 // n/4
-		for i := int64(0); float64(i) <= cap; i++ {
-			ib := inew(i)
-			powI1 := pow(inew(-1), ib) // (-1)^i
-			ifact := fact(ib)          // i!
-			powI2 := pow(inew(4), ib)  // 4^i
-			frac := fdiv(itof(powI1), fmul(itof(ifact), itof(powI2)))
-			sum = fadd(sum, frac)
-		}
-		a[n] = floor(fmul(itof(fact(inew(n))), sum))
-		sum = fzero() // reset when done
-	}
-	return a, 0
+func A000138(seqlen int64) ([]*bint, int64, error) {
+	// exp(-x^4/4) / (1-x)
+	num := utils.Monomial(seqlen, rnew(-1, 4), 4).Exp()
+	a, err := num.Div(utils.NewSeries(seqlen, 1, -1)).Exponential()
+	return a, 0, err
 }
 
 /**
@@ -418,7 +512,7 @@ func A000138(seqlen int64) ([]*bint, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000139
  */
-func A000139(seqlen int64) ([]*bint, int64) {
+func A000139(seqlen int64) ([]*bint, int64, error) {
 	// a(n) = 2(3n)!/((2n+1)!*(n+1)!)
 	a := iSlice(seqlen)
 	for n := int64(0); n < seqlen; n++ {
@@ -429,7 +523,7 @@ func A000139(seqlen int64) ([]*bint, int64) {
 		denom := mul(twonplus1, nplus1)
 		a[n] = floor(fdiv(itof(numer), itof(denom)))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -437,13 +531,21 @@ func A000139(seqlen int64) ([]*bint, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000142
  */
-func A000142(seqlen int64) ([]*bint, int64) {
+func A000142(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000142", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = fact(inew(0))
 	for i := int64(1); i < seqlen; i++ {
 		a[i] = mul(a[i-1], inew(i))
 	}
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000142 computes the single term a(n) = n!
+func termA000142(n int64) (*bint, error) {
+	return utils.Factorial(n), nil
 }
 
 /**
@@ -451,7 +553,7 @@ func A000142(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000148
  */
-func A000148(seqlen int64) ([]int64, int64) {
+func A000148(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	offset := int64(2) // OEIS has it listed as 2
 
@@ -466,7 +568,7 @@ func A000148(seqlen int64) ([]int64, int64) {
 		a[n-offset] = sum
 	}
 
-	return a, offset
+	return a, offset, nil
 }
 
 /**
@@ -474,12 +576,12 @@ func A000148(seqlen int64) ([]int64, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000149
  */
-func A000149(seqlen int64) ([]*bint, int64) {
+func A000149(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = floor(fpow(fnew(math.E), i))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -488,7 +590,10 @@ func A000149(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000150
  */
-func A000150(seqlen int64) ([]*bint, int64) {
+func A000150(seqlen int64) ([]*bint, int64, error) {
+	if seqlen > OVERFLOW_A000150 {
+		return nil, 0, &utils.OverflowError{Seq: "A000150", Max: OVERFLOW_A000150}
+	}
 	a := iSlice(seqlen)
 
 	for n := int64(1); n < seqlen; n++ {
@@ -508,7 +613,7 @@ func A000150(seqlen int64) ([]*bint, int64) {
 
 		a[n] = round(bcdef)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -516,16 +621,12 @@ func A000150(seqlen int64) ([]*bint, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000153
  */
-func A000153(seqlen int64) ([]*bint, int64) {
-	a := iSlice(seqlen)
-	a[0] = inew(0)
-	a[1] = inew(1)
-
-	for i := int64(2); i < seqlen; i++ {
-		j := inew(i)
-		a[i] = add(mul(j, a[i-1]), mul(sub(j, inew(2)), a[i-2]))
+func A000153(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000153", seqlen, 2); err != nil {
+		return nil, 0, err
 	}
-	return a, 0
+	a, err := holA000153.Terms(seqlen)
+	return a, 0, err
 }
 
 /**
@@ -533,9 +634,7 @@ func A000153(seqlen int64) ([]*bint, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000158
  */
-func A000158(seqlen int64) ([]int64, int64) {
-	utils.LongCalculationWarning("A000158")
-
+func A000158(seqlen int64) ([]int64, int64, error) {
 	check := func(x1, x2, x3, n int64) bool {
 		twothirds := 2.0 / 3.0
 		return int64(math.Ceil(math.Pow(float64(x1), twothirds)+
@@ -557,7 +656,7 @@ func A000158(seqlen int64) ([]int64, int64) {
 		}
 		a[i] = count
 	}
-	return a, 3
+	return a, 3, nil
 }
 
 /**
@@ -566,9 +665,7 @@ func A000158(seqlen int64) ([]int64, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000160
  */
-func A000160(seqlen int64) ([]int64, int64) {
-	utils.LongCalculationWarning("A000160")
-
+func A000160(seqlen int64) ([]int64, int64, error) {
 	check := func(x1, x2, x3, x4, n int64) bool {
 		twothirds := 2.0 / 3.0
 		return int64(math.Ceil(math.Pow(float64(x1), twothirds)+
@@ -593,7 +690,7 @@ func A000160(seqlen int64) ([]int64, int64) {
 		}
 		a[i] = count
 	}
-	return a, 4
+	return a, 4, nil
 }
 
 /**
@@ -601,7 +698,7 @@ func A000160(seqlen int64) ([]int64, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000161
  */
-func A000161(seqlen int64) ([]int64, int64) {
+func A000161(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		count := int64(0)
@@ -616,7 +713,7 @@ func A000161(seqlen int64) ([]int64, int64) {
 		}
 		a[n] = count
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -624,7 +721,7 @@ func A000161(seqlen int64) ([]int64, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000164
  */
-func A000164(seqlen int64) ([]int64, int64) {
+func A000164(seqlen int64) ([]int64, int64, error) {
 	getCounts := func(n int64) int64 { // computes the # of partitions of n
 		count := int64(0)
 		for x := 0; ; x++ {
@@ -655,7 +752,7 @@ func A000164(seqlen int64) ([]int64, int64) {
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = getCounts(i)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -663,12 +760,17 @@ func A000164(seqlen int64) ([]int64, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000165
  */
-func A000165(seqlen int64) ([]*bint, int64) {
+func A000165(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = mul(pow(inew(2), inew(i)), fact(inew(i)))
 	}
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000165 computes the single term a(n) = 2^n*n!
+func termA000165(n int64) (*bint, error) {
+	return mul(pow(inew(2), inew(n)), utils.Factorial(n)), nil
 }
 
 /**
@@ -677,15 +779,12 @@ func A000165(seqlen int64) ([]*bint, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000166
  */
-func A000166(seqlen int64) ([]*bint, int64) {
-	a := iSlice(seqlen)
-	a[0] = inew(1)
-
-	for i := int64(1); i < seqlen; i++ {
-		a[i] = add(mul(inew(i), a[i-1]), pow(inew(-1), inew(i)))
+func A000166(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000166", seqlen, 1); err != nil {
+		return nil, 0, err
 	}
-
-	return a, 0
+	a, err := holA000166.Terms(seqlen)
+	return a, 0, err
 }
 
 /**
@@ -693,7 +792,7 @@ func A000166(seqlen int64) ([]*bint, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000168
  */
-func A000168(seqlen int64) ([]*bint, int64) {
+func A000168(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		twon := fact(mul(inew(2), inew(i)))      // (2n)!
@@ -703,7 +802,7 @@ func A000168(seqlen int64) ([]*bint, int64) {
 		denom := mul(fact(inew(i)), nplus2)      // n!*(n+2)!
 		a[i] = div(numer, denom)                 // 2*3^n*(2n)!/(n!*(n+2)!)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -711,12 +810,12 @@ func A000168(seqlen int64) ([]*bint, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000169
  */
-func A000169(seqlen int64) ([]*bint, int64) {
+func A000169(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(1); i <= seqlen; i++ {
 		a[i-1] = pow(inew(i), sub(inew(i), inew(1)))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -724,14 +823,14 @@ func A000169(seqlen int64) ([]*bint, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000172
  */
-func A000172(seqlen int64) ([]*bint, int64) {
+func A000172(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		for j := int64(0); j <= i; j++ {
 			a[i] = add(a[i], pow(inew(utils.Binomial(inew(i).Int64(), inew(j).Int64())), inew(3)))
 		}
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -739,9 +838,7 @@ func A000172(seqlen int64) ([]*bint, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000174
  */
-func A000174(seqlen int64) ([]int64, int64) {
-	utils.LongCalculationWarning("A000174")
-
+func A000174(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		count := int64(0)
@@ -769,7 +866,7 @@ func A000174(seqlen int64) ([]int64, int64) {
 		}
 		a[n] = count
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -777,9 +874,7 @@ func A000174(seqlen int64) ([]int64, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000177
  */
-func A000177(seqlen int64) ([]int64, int64) {
-	utils.LongCalculationWarning("A000174")
-
+func A000177(seqlen int64) ([]int64, int64, error) {
 	// this is a really nasty algorithm
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
@@ -810,7 +905,7 @@ func A000177(seqlen int64) ([]int64, int64) {
 		}
 		a[n] = count
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -818,14 +913,20 @@ func A000177(seqlen int64) ([]int64, int64) {
  * Date		December 10, 2021
  * Link		https://oeis.org/A000178
  */
-func A000178(seqlen int64) ([]*bint, int64) {
+func A000178(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000178", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = fact(inew(0))
-	facts, _ := A000142(seqlen)
+	facts, _, err := A000142(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(1); i < seqlen; i++ {
 		a[i] = mul(a[i-1], facts[i])
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -835,23 +936,12 @@ func A000178(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000179
  */
-func A000179(seqlen int64) ([]*bint, int64) {
-	a := iSlice(seqlen)
-	a[0] = inew(1)
-	a[1] = inew(-1)
-	a[2] = inew(0)
-	//a(n) = ((n^2-2*n)*a(n-1) + n*a(n-2) - 4*(-1)^n)/(n-2) for n >= 3.
-	for n := int64(3); n < seqlen; n++ {
-		b := sub(pow(inew(n), inew(2)), mul(inew(2), inew(n))) // n^2-2*n
-		c := mul(b, a[n-1])                                    //(n^2-2*n)*a(n-1)
-		d := mul(inew(n), a[n-2])                              // n*a(n-2)
-		e := add(c, d)                                         // (n^2-2*n)*a(n-1) + n*a(n-2)
-		f := mul(inew(4), pow(inew(-1), inew(n)))              // 4*(-1)^n
-		g := sub(e, f)
-		h := fdiv(itof(g), fnew(float64(n-2)))
-		a[n] = round(h)
-	}
-	return a, 0
+func A000179(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000179", seqlen, 3); err != nil {
+		return nil, 0, err
+	}
+	a, err := holA000179.Terms(seqlen)
+	return a, 0, err
 }
 
 /**
@@ -860,9 +950,7 @@ func A000179(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000182
  */
-func A000182(seqlen int64) ([]*bint, int64) {
-	utils.LongCalculationWarning("A000182")
-
+func A000182(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(1); n <= seqlen; n++ {
 		b := pow(inew(2), mul(inew(2), inew(n)))
@@ -873,7 +961,7 @@ func A000182(seqlen int64) ([]*bint, int64) {
 		denom := mul(inew(2), inew(n))
 		a[n-1] = abs(div(floor(numer), denom))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -882,10 +970,9 @@ func A000182(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000184
  */
-func A000184(seqlen int64) ([]*bint, int64) {
+func A000184(seqlen int64) ([]*bint, int64, error) {
 	if seqlen > OVERFLOW_A000184 {
-		utils.PrintDebug("Problem: This uses golang's built-in Gamma function, which eventually overflows.")
-		utils.OverflowError("A000184", OVERFLOW_A000184)
+		return nil, 0, &utils.OverflowError{Seq: "A000184", Max: OVERFLOW_A000184}
 	}
 
 	a := iSlice(seqlen)
@@ -900,7 +987,7 @@ func A000184(seqlen int64) ([]*bint, int64) {
 		f := mul(inew(n), pow(inew(4), inew(n-1)))
 		a[n-2] = round(fsub(frac, itof(f)))
 	}
-	return a, 2
+	return a, 2, nil
 }
 
 /**
@@ -910,7 +997,7 @@ func A000184(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000188
  */
-func A000188(seqlen int64) ([]int64, int64) {
+func A000188(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(1); n < seqlen; n++ {
 		count := int64(0)
@@ -922,7 +1009,7 @@ func A000188(seqlen int64) ([]int64, int64) {
 		}
 		a[n-1] = count
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -930,7 +1017,7 @@ func A000188(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000189
  */
-func A000189(seqlen int64) ([]int64, int64) {
+func A000189(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(1); n < seqlen; n++ {
 		count := int64(0)
@@ -942,7 +1029,7 @@ func A000189(seqlen int64) ([]int64, int64) {
 		}
 		a[n-1] = count
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -950,7 +1037,7 @@ func A000189(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000189
  */
-func A000190(seqlen int64) ([]int64, int64) {
+func A000190(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(1); n < seqlen; n++ {
 		count := int64(0)
@@ -962,7 +1049,7 @@ func A000190(seqlen int64) ([]int64, int64) {
 		}
 		a[n-1] = count
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -970,12 +1057,12 @@ func A000190(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000193
  */
-func A000193(seqlen int64) ([]int64, int64) {
+func A000193(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(1); n <= seqlen; n++ {
 		a[n-1] = int64(math.Round(math.Log(float64(n))))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -984,12 +1071,12 @@ func A000193(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000194
  */
-func A000194(seqlen int64) ([]int64, int64) {
+func A000194(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = int64(math.Round(math.Sqrt(float64(n))))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -997,12 +1084,12 @@ func A000194(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000195
  */
-func A000195(seqlen int64) ([]int64, int64) {
+func A000195(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(1); n <= seqlen; n++ {
 		a[n-1] = int64(math.Floor(math.Log(float64(n))))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -1012,12 +1099,12 @@ func A000195(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000196
  */
-func A000196(seqlen int64) ([]int64, int64) {
+func A000196(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = utils.Isqrt(n)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -1025,13 +1112,10 @@ func A000196(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000197
  */
-func A000197(seqlen int64) ([]*bint, int64) {
-	utils.PrintDebug("A000197 computes (n!)!, which gets large EXTREMELY quickly. This can crash your terminal if you choose a value too large!")
-	utils.LongCalculationWarning("A000197")
-
+func A000197(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = fact(fact(inew(n)))
 	}
-	return a, 0
+	return a, 0, nil
 }
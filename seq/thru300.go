@@ -9,7 +9,6 @@ package seq
 
 import (
 	"OEIS/utils"
-	"fmt"
 	"math"
 )
 
@@ -17,19 +16,116 @@ const (
 	LONG_A000205 = 10
 )
 
+// the recurrences behind sequences of this file
+var (
+	// a(n) = a(n-1) + a(n-2) - 2
+	recA000211 = utils.LinearRecurrence{Coeffs: []int64{1, 1}, Init: []int64{4, 3},
+		Extra: []utils.Inhomogeneous{{Poly: []int64{-2}, Base: 1}}}
+	// a(n) = 2a(n-1) - a(n-2) + a(n-3) + 2^(n-1)
+	recA000253 = utils.LinearRecurrence{Coeffs: []int64{2, -1, 1}, Init: []int64{0, 1, 4},
+		Extra: []utils.Inhomogeneous{{Poly: []int64{1}, Base: 2, Shift: 1}}}
+	// a(n) = n a(n-1) + (n-1) a(n-2)
+	holA000255 = utils.NewHolonomic([][]int64{{1}, {0, 1}, {-1, 1}}, []int64{1, 1})
+	// a(n) = n a(n-1) + (n-3) a(n-2), from a(1), so n is shifted by 1
+	holA000261 = utils.NewHolonomic([][]int64{{1}, {1, 1}, {-2, 1}}, []int64{0, 1})
+)
+
+// registers every sequence in this file
+func init() {
+	registerInt("A000201", 1, A000201)
+	registerInt("A000202", 1, A000202)
+	registerInt("A000203", 1, A000203)
+	registerBig("A000204", 1, A000204)
+	registerBig("A000205", 0, A000205)
+	registerBig("A000207", 1, A000207)
+	registerBig("A000208", 0, A000208)
+	registerInt("A000209", 0, A000209)
+	registerInt("A000210", 1, A000210)
+	registerBig("A000211", 0, A000211)
+	registerInt("A000212", 0, A000212)
+	registerBig("A000213", 0, A000213)
+	registerBig("A000215", 0, A000215)
+	registerInt("A000216", 1, A000216)
+	registerInt("A000217", 0, A000217)
+	registerInt("A000218", 1, A000218)
+	registerBig("A000219", 0, A000219)
+	registerInt("A000221", 1, A000221)
+	registerBig("A000225", 0, A000225)
+	registerBig("A000227", 0, A000227)
+	registerBig("A000230", 0, A000230)
+	registerBig("A000231", 1, A000231)
+	registerBig("A000240", 1, A000240)
+	registerBig("A000244", 0, A000244)
+	registerBig("A000245", 0, A000245)
+	registerBig("A000246", 0, A000246)
+	registerBig("A000247", 2, A000247)
+	registerBig("A000248", 0, A000248)
+	registerInt("A000252", 1, A000252)
+	registerBig("A000253", 0, A000253)
+	registerBig("A000254", 0, A000254)
+	registerBig("A000255", 0, A000255)
+	registerBig("A000256", 3, A000256)
+	registerBig("A000257", 0, A000257)
+	registerBig("A000259", 1, A000259)
+	registerBig("A000260", 0, A000260)
+	registerBig("A000261", 1, A000261)
+	registerBig("A000262", 0, A000262)
+	registerInt("A000263", 3, A000263)
+	registerInt("A000265", 1, A000265)
+	registerBig("A000266", 0, A000266)
+	registerInt("A000267", 0, A000267)
+	registerBig("A000270", 0, A000270)
+	registerBig("A000271", 0, A000271)
+	registerBig("A000272", 0, A000272)
+	registerBig("A000274", 1, A000274)
+	registerBig("A000275", 0, A000275)
+	registerBig("A000276", 4, A000276)
+	registerInt("A000277", 0, A000277)
+	registerBig("A000278", 0, A000278)
+	registerBig("A000279", 1, A000279)
+	registerBig("A000280", 0, A000280)
+	registerBig("A000283", 0, A000283)
+	registerBig("A000284", 0, A000284)
+	registerBig("A000285", 0, A000285)
+	registerBig("A000286", 0, A000286)
+	registerBig("A000287", 6, A000287)
+	registerBig("A000288", 0, A000288)
+	registerBig("A000289", 0, A000289)
+	registerBig("A000290", 0, A000290)
+	registerInt("A000291", 0, A000291)
+	registerInt("A000292", 0, A000292)
+	registerBig("A000294", 0, A000294)
+	registerBig("A000295", 0, A000295)
+	registerBig("A000296", 0, A000296)
+	registerInt("A000297", -1, A000297)
+
+	// sequences that compute a(n) directly
+	registerTerm("A000203", termA000203)
+	registerTerm("A000204", termA000204)
+	registerTerm("A000211", termA000211)
+	registerTerm("A000217", termA000217)
+	registerTerm("A000253", termA000253)
+	registerTerm("A000288", termA000288)
+	registerTerm("A000290", termA000290)
+
+	// sequences that warn about how long they take, or how accurate they are
+	registerDiagnostics("A000205", always(utils.LongCalculationWarning("A000205")))
+	registerDiagnostics("A000207", always(utils.AccuracyWarning("A000207")))
+}
+
 /**
  * A000201 computes the Lower Wythoff sequence (a Beatty sequence):
  *  a(n) = floor(n*phi), where phi = (1+sqrt(5))/2 = A001622
  * Date		December 12, 2021
  * Link		https://oeis.org/A000201
  */
-func A000201(seqlen int64) ([]int64, int64) {
+func A000201(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	phi := (1.0 + math.Sqrt(5)) / 2.0
 	for n := int64(1); n <= seqlen; n++ {
 		a[n-1] = int64(math.Floor(float64(n) * phi))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -37,12 +133,12 @@ func A000201(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000202
  */
-func A000202(seqlen int64) ([]int64, int64) {
+func A000202(seqlen int64) ([]int64, int64, error) {
 	b := []int64{1, 3, 4, 6, 8, 9, 11, 12}
 	a := utils.InitIslice(seqlen, b)
 
 	if seqlen < 8 {
-		return a, 1
+		return a, 1, nil
 	}
 
 	foo := int64(8)
@@ -52,7 +148,7 @@ func A000202(seqlen int64) ([]int64, int64) {
 			a[foo*i+j-1] = 13*i + a[j-1]
 		}
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -61,12 +157,13 @@ func A000202(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000203
  */
-func A000203(seqlen int64) ([]int64, int64) {
-	a := make([]int64, seqlen)
-	for i := int64(1); i <= seqlen; i++ {
-		a[i-1] = utils.Sum(utils.Factors(i))
-	}
-	return a, 1
+func A000203(seqlen int64) ([]int64, int64, error) {
+	return utils.DivisorSigma(1).Table(seqlen)[1:], 1, nil
+}
+
+// termA000203 computes the single term a(n) = sigma(n)
+func termA000203(n int64) (*bint, error) {
+	return inew(utils.DivisorSigma(1).Eval(n)), nil
 }
 
 /**
@@ -75,9 +172,20 @@ func A000203(seqlen int64) ([]int64, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000204
  */
-func A000204(seqlen int64) ([]*bint, int64) {
-	a, _ := A000032(seqlen + 1)
-	return a[1:], 1
+func A000204(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000204", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
+	a, _, err := A000032(seqlen + 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	return a[1:], 1, nil
+}
+
+// termA000204 computes the single term a(n) = L(n) by fast doubling
+func termA000204(n int64) (*bint, error) {
+	return utils.Lucas(n), nil
 }
 
 /**
@@ -85,10 +193,12 @@ func A000204(seqlen int64) ([]*bint, int64) {
  * Date		December 12, 2021
  * Link		https://oeis.org/A000205
  */
-func A000205(seqlen int64) ([]*bint, int64) {
-	utils.LongCalculationWarning("A000205")
+func A000205(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000205", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := utils.Repr(seqlen, 1, 3, 1)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -99,13 +209,14 @@ func A000205(seqlen int64) ([]*bint, int64) {
  * Date		December 13, 2021	Accurate as of:
  * Link		https://oeis.org/A000207
  */
-func A000207(seqlen int64) ([]*bint, int64) {
-	utils.AccuracyWarning("A000207")
-
+func A000207(seqlen int64) ([]*bint, int64, error) {
 	a := utils.InitBslice(seqlen,
 		[]*bint{inew(1), inew(1)})
 
-	C, _ := A000108(seqlen + 3)        // catalan numbers
+	C, _, err := A000108(seqlen + 3) // catalan numbers
+	if err != nil {
+		return nil, 0, err
+	}
 	C = utils.ShiftBigSliceRight(C, 2) // C(n)=A000108(n-2)
 	for n := int64(3); n <= seqlen+2; n++ {
 		k := (n + 1) / 2 // n is odd
@@ -120,7 +231,7 @@ func A000207(seqlen int64) ([]*bint, int64) {
 			fdiv(itof(C[k]), fnew(2)),
 			fdiv(itof(C[n/3+1]), fnew(3))))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -129,9 +240,15 @@ func A000207(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000208
  */
-func A000208(seqlen int64) ([]*bint, int64) {
+func A000208(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000208", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
-	a13, _ := A000013(seqlen * 2)
+	a13, _, err := A000013(seqlen * 2)
+	if err != nil {
+		return nil, 0, err
+	}
 	for i := int64(0); i < seqlen; i++ {
 		if i%2 == 0 {
 			a[i] = div(add(a13[2*i], a13[i]), inew(2))
@@ -139,7 +256,7 @@ func A000208(seqlen int64) ([]*bint, int64) {
 			a[i] = div(a13[2*i], inew(2))
 		}
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -147,12 +264,12 @@ func A000208(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000209
  */
-func A000209(seqlen int64) ([]int64, int64) {
+func A000209(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = int64(math.Round(math.Tan(float64(i))))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -160,12 +277,12 @@ func A000209(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000210
  */
-func A000210(seqlen int64) ([]int64, int64) {
+func A000210(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(1); i <= seqlen; i++ {
 		a[i-1] = int64(math.Floor(float64(i) * (math.E - 1.0)))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -173,14 +290,13 @@ func A000210(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000211
  */
-func A000211(seqlen int64) ([]*bint, int64) {
-	a := utils.InitBslice(seqlen,
-		[]*bint{inew(4), inew(3)})
+func A000211(seqlen int64) ([]*bint, int64, error) {
+	return recA000211.Terms(seqlen), 0, nil
+}
 
-	for i := int64(2); i < seqlen; i++ {
-		a[i] = sub(add(a[i-1], a[i-2]), inew(2))
-	}
-	return a, 0
+// termA000211 computes the single term a(n) from the recurrence
+func termA000211(n int64) (*bint, error) {
+	return recA000211.Term(n), nil
 }
 
 /**
@@ -188,12 +304,12 @@ func A000211(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000212
  */
-func A000212(seqlen int64) ([]int64, int64) {
+func A000212(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = int64(math.Floor(math.Pow(float64(i), 2) / 3.0))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -202,14 +318,14 @@ func A000212(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000213
  */
-func A000213(seqlen int64) ([]*bint, int64) {
+func A000213(seqlen int64) ([]*bint, int64, error) {
 	a := utils.InitBslice(seqlen,
 		[]*bint{inew(1), inew(1), inew(1)})
 
 	for i := int64(3); i < seqlen; i++ {
 		a[i] = add(add(a[i-1], a[i-2]), a[i-3])
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -217,12 +333,12 @@ func A000213(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000215
  */
-func A000215(seqlen int64) ([]*bint, int64) {
+func A000215(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = add(pow(inew(2), pow(inew(2), inew(i))), inew(1))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -230,13 +346,16 @@ func A000215(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000216
  */
-func A000216(seqlen int64) ([]int64, int64) {
+func A000216(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000216", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := make([]int64, seqlen)
 	a[0] = 2
 	for n := int64(1); n < seqlen; n++ {
 		a[n] += utils.SumSquares(a[n-1])
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -244,12 +363,17 @@ func A000216(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000217
  */
-func A000217(seqlen int64) ([]int64, int64) {
+func A000217(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = i * (i + 1) / 2
 	}
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000217 computes the single term a(n) = n(n+1)/2
+func termA000217(n int64) (*bint, error) {
+	return quo(mul(inew(n), inew(n+1)), inew(2)), nil
 }
 
 /**
@@ -257,13 +381,16 @@ func A000217(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000218
  */
-func A000218(seqlen int64) ([]int64, int64) {
+func A000218(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000218", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := make([]int64, seqlen)
 	a[0] = 3
 	for n := int64(1); n < seqlen; n++ {
 		a[n] += utils.SumSquares(a[n-1])
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -271,7 +398,10 @@ func A000218(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000219
  */
-func A000219(seqlen int64) ([]*bint, int64) {
+func A000219(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000219", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(1)
 	for n := int64(1); n < seqlen; n++ {
@@ -282,7 +412,7 @@ func A000219(seqlen int64) ([]*bint, int64) {
 		}
 		a[n] = floor(fmul(inv, sum))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -290,13 +420,16 @@ func A000219(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000221
  */
-func A000221(seqlen int64) ([]int64, int64) {
+func A000221(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000221", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := make([]int64, seqlen)
 	a[0] = 5
 	for n := int64(1); n < seqlen; n++ {
 		a[n] += utils.SumSquares(a[n-1])
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -304,13 +437,16 @@ func A000221(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000225
  */
-func A000225(seqlen int64) ([]*bint, int64) {
+func A000225(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
-	sq, _ := A000079(seqlen)
+	sq, _, err := A000079(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(1); n < seqlen; n++ {
 		a[n] = sub(sq[n], inew(1))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -318,12 +454,12 @@ func A000225(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000227
  */
-func A000227(seqlen int64) ([]*bint, int64) {
+func A000227(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(0); i < seqlen; i++ {
 		a[i] = round(fpow(fnew(math.E), i))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -332,33 +468,23 @@ func A000227(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000230
  */
-func A000230(seqlen int64) ([]*bint, int64) {
+func A000230(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000230", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(2)
 
-	primes := utils.PrimesBig(seqlen)
-	lenfactor := 25 // how many times more primes to compute
-
-	// loop to compute a
-	for n := int64(1); n < seqlen; {
-		oldn := n
-		for k := 0; k < len(primes)-1; k++ {
-			test := add(primes[k], mul(inew(2), inew(n)))
-			if equals(test, primes[k+1]) {
-				a[n] = primes[k]
-				n++
-				k = len(primes) + 1
-				//fmt.Println("for n =", n, "primes computed = ", len(primes), "\nwith", primes[len(primes)-1], "as the last prime")
-			}
-		}
-
-		// this will only be true if the # of primes generated wasn't enough
-		// and the value of a[n] wasn't found
-		if oldn == n {
-			primes = utils.PrimesBig(int64(len(primes) * lenfactor))
+	// walk the consecutive primes once, keeping the first one followed by
+	// each gap 2n
+	it := utils.NewPrimeIter(3)
+	for p, q, found := it.Next(), it.Next(), int64(1); found < seqlen; p, q = q, it.Next() {
+		if n := (q - p) / 2; n < seqlen && a[n].Sign() == 0 {
+			a[n] = inew(p)
+			found++
 		}
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -367,7 +493,7 @@ func A000230(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000231
  */
-func A000231(seqlen int64) ([]*bint, int64) {
+func A000231(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(1); n <= seqlen; n++ {
 		// a(n) = (2^(2^n)+(2^n-1)*2^(2^(n-1)))/2^n
@@ -377,7 +503,7 @@ func A000231(seqlen int64) ([]*bint, int64) {
 		numer := add(pt1, mul(pt2, pt3))             // (2^(2^n)+(2^n-1)*2^(2^(n-1)))
 		a[n-1] = div(numer, pow(inew(2), inew(n)))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -386,7 +512,7 @@ func A000231(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000240
  */
-func A000240(seqlen int64) ([]*bint, int64) {
+func A000240(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(1); n <= seqlen; n++ {
 		for k := int64(0); k < n; k++ {
@@ -396,7 +522,7 @@ func A000240(seqlen int64) ([]*bint, int64) {
 					div(fact(inew(n)), fact(inew(k)))))
 		}
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -404,12 +530,12 @@ func A000240(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000244
  */
-func A000244(seqlen int64) ([]*bint, int64) {
+func A000244(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = pow(inew(3), inew(n))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -417,14 +543,14 @@ func A000244(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000245
  */
-func A000245(seqlen int64) ([]*bint, int64) {
+func A000245(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(1); n < seqlen; n++ {
 		numer := mul(inew(3), fact(inew(2*n)))
 		denom := mul(fact(inew(n+2)), fact(inew(n-1)))
 		a[n] = div(numer, denom)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -432,7 +558,10 @@ func A000245(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000246
  */
-func A000246(seqlen int64) ([]*bint, int64) {
+func A000246(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000246", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(1)
 	for n := int64(1); n < seqlen; n++ {
@@ -446,7 +575,7 @@ func A000246(seqlen int64) ([]*bint, int64) {
 		}
 		a[n] = sum
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -454,12 +583,12 @@ func A000246(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000247
  */
-func A000247(seqlen int64) ([]*bint, int64) {
+func A000247(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for i := int64(2); i <= seqlen+1; i++ {
 		a[i-2] = sub(pow(inew(2), inew(i)), inew(i+2))
 	}
-	return a, 2
+	return a, 2, nil
 }
 
 /**
@@ -467,17 +596,10 @@ func A000247(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000248
  */
-func A000248(seqlen int64) ([]*bint, int64) {
-	// a(n) = Sum_{k=0..n} C(n,k)*(n-k)^k
-	a := iSlice(seqlen)
-	for n := int64(0); n < seqlen; n++ {
-		sum := inew(0)
-		for k := int64(0); k <= n; k++ {
-			sum = add(sum, mul(nCr(inew(n), inew(k)), pow(inew(n-k), inew(k))))
-		}
-		a[n] = sum
-	}
-	return a, 0
+func A000248(seqlen int64) ([]*bint, int64, error) {
+	x := utils.Monomial(seqlen, rnew(1, 1), 1)
+	a, err := x.Mul(x.Exp()).Exp().Exponential()
+	return a, 0, err
 }
 
 /**
@@ -485,7 +607,7 @@ func A000248(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000252
  */
-func A000252(seqlen int64) ([]int64, int64) {
+func A000252(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(1); n <= seqlen; n++ {
 		prod := float64(1)
@@ -498,7 +620,7 @@ func A000252(seqlen int64) ([]int64, int64) {
 		}
 		a[n-1] = int64(math.Floor(math.Pow(float64(n), 4.0) * prod))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -506,15 +628,13 @@ func A000252(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000253
  */
-func A000253(seqlen int64) ([]*bint, int64) {
-	a := utils.InitBslice(seqlen,
-		[]*bint{inew(0), inew(1), inew(4), inew(11)})
-
-	for n := int64(3); n < seqlen; n++ {
-		a[n] = add(add(sub(mul(inew(2), a[n-1]), a[n-2]), a[n-3]), pow(inew(2), inew(n-1)))
-	}
+func A000253(seqlen int64) ([]*bint, int64, error) {
+	return recA000253.Terms(seqlen), 0, nil
+}
 
-	return a, 0
+// termA000253 computes the single term a(n) from the recurrence
+func termA000253(n int64) (*bint, error) {
+	return recA000253.Term(n), nil
 }
 
 /**
@@ -523,12 +643,12 @@ func A000253(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000254
  */
-func A000254(seqlen int64) ([]*bint, int64) {
+func A000254(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(1); n < seqlen; n++ {
 		a[n] = add(mul(inew(n), a[n-1]), fact(inew(n-1)))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -536,14 +656,9 @@ func A000254(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000255
  */
-func A000255(seqlen int64) ([]*bint, int64) {
-	a := utils.InitBslice(seqlen,
-		[]*bint{inew(1), inew(1)})
-
-	for n := int64(2); n < seqlen; n++ {
-		a[n] = add(mul(inew(n), a[n-1]), mul(inew(n-1), a[n-2]))
-	}
-	return a, 0
+func A000255(seqlen int64) ([]*bint, int64, error) {
+	a, err := holA000255.Terms(seqlen)
+	return a, 0, err
 }
 
 /**
@@ -551,7 +666,7 @@ func A000255(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000256
  */
-func A000256(seqlen int64) ([]*bint, int64) {
+func A000256(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	bound := int64(3)
 	if seqlen <= 3 {
@@ -575,7 +690,7 @@ func A000256(seqlen int64) ([]*bint, int64) {
 		denom := add(sub(mul(inew(8), pow(inew(n), inew(2))), inew(51*n)), inew(81))
 		a[n-3] = floor(fdiv(numer, itof(denom)))
 	}
-	return a, 3
+	return a, 3, nil
 }
 
 /**
@@ -583,13 +698,16 @@ func A000256(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000257
  */
-func A000257(seqlen int64) ([]*bint, int64) {
+func A000257(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000257", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(1)
 	for n := int64(1); n < seqlen; n++ {
 		a[n] = div(mul(inew(8*n-4), a[n-1]), inew(n+2))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -597,9 +715,12 @@ func A000257(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000259
  */
-func A000259(seqlen int64) ([]*bint, int64) {
+func A000259(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
-	F, _ := A000045(seqlen)
+	F, _, err := A000045(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	// a(n) = Sum_{k = 1..n} (-1)^(k-1)*C(3n, n-k)*k/n*F(k-2)
 	for n := int64(1); n <= seqlen; n++ {
@@ -617,7 +738,7 @@ func A000259(seqlen int64) ([]*bint, int64) {
 		}
 		a[n-1] = floor(sum)
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -627,13 +748,16 @@ func A000259(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000260
  */
-func A000260(seqlen int64) ([]*bint, int64) {
+func A000260(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000260", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(1)
 	for n := int64(1); n < seqlen; n++ {
 		a[n] = sub(nCr(inew(4*n+1), inew(n+1)), mul(inew(9), nCr(inew(4*n+1), inew(n-1))))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -641,15 +765,9 @@ func A000260(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000261
  */
-func A000261(seqlen int64) ([]*bint, int64) {
-	a := utils.InitBslice(seqlen,
-		[]*bint{inew(0), inew(1)})
-
-	for n := int64(3); n <= seqlen; n++ {
-		// a(n) = n*a(n-1) + (n-3)*a(n-2), with a(1) = 0, a(2) = 1.
-		a[n-1] = add(mul(inew(n), a[n-2]), mul(inew(n-3), a[n-3]))
-	}
-	return a, 1
+func A000261(seqlen int64) ([]*bint, int64, error) {
+	a, err := holA000261.Terms(seqlen)
+	return a, 1, err
 }
 
 /**
@@ -659,7 +777,10 @@ func A000261(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000262
  */
-func A000262(seqlen int64) ([]*bint, int64) {
+func A000262(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000262", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(1)
 	for n := int64(1); n < seqlen; n++ {
@@ -672,7 +793,7 @@ func A000262(seqlen int64) ([]*bint, int64) {
 		}
 		a[n] = floor(fmul(itof(fact(inew(n-1))), sum))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -680,7 +801,7 @@ func A000262(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000263
  */
-func A000263(seqlen int64) ([]int64, int64) {
+func A000263(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(3); n <= seqlen+2; n++ {
 		nf := float64(n)
@@ -696,7 +817,7 @@ func A000263(seqlen int64) ([]int64, int64) {
 		}
 		a[n-3] = count
 	}
-	return a, 3
+	return a, 3, nil
 }
 
 /**
@@ -704,16 +825,8 @@ func A000263(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000265
  */
-func A000265(seqlen int64) ([]int64, int64) {
-	a := make([]int64, seqlen)
-	for n := int64(1); n <= seqlen; n++ {
-		if n%2 != 0 {
-			a[n-1] = n
-		} else {
-			a[n-1] = a[n/2-1]
-		}
-	}
-	return a, 1
+func A000265(seqlen int64) ([]int64, int64, error) {
+	return utils.LargestOddDivisor.Table(seqlen)[1:], 1, nil
 }
 
 /**
@@ -721,19 +834,11 @@ func A000265(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000266
  */
-func A000266(seqlen int64) ([]*bint, int64) {
-	a := iSlice(seqlen)
-	for n := int64(0); n < seqlen; n++ {
-		//a(n) = n! * Sum_{i=0..floor(n/2)} (-1)^i /(i! * 2^i)
-		sum := fnew(0)
-		for i := int64(0); i <= n/2; i++ {
-			num := fpow(fnew(-1), i)
-			den := fmul(itof(fact(inew(i))), fpow(fnew(2), i))
-			sum = fadd(sum, fdiv(num, den))
-		}
-		a[n] = floor(fmul(itof(fact(inew(n))), sum))
-	}
-	return a, 0
+func A000266(seqlen int64) ([]*bint, int64, error) {
+	// exp(-x^2/2) / (1-x)
+	num := utils.Monomial(seqlen, rnew(-1, 2), 2).Exp()
+	a, err := num.Div(utils.NewSeries(seqlen, 1, -1)).Exponential()
+	return a, 0, err
 }
 
 /**
@@ -741,12 +846,12 @@ func A000266(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000267
  */
-func A000267(seqlen int64) ([]int64, int64) {
+func A000267(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = utils.Isqrt(4*n + 1)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -755,15 +860,21 @@ func A000267(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000270
  */
-func A000270(seqlen int64) ([]*bint, int64) {
+func A000270(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000270", seqlen, 2); err != nil {
+		return nil, 0, err
+	}
 	a := utils.InitBslice(seqlen,
 		[]*bint{inew(1), inew(1)})
 
-	b, _ := A000179(seqlen + 1)
+	b, _, err := A000179(seqlen + 1)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(2); n < seqlen; n++ {
 		a[n] = add(add(b[n+1], b[n]), b[n-1])
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /*
@@ -771,7 +882,7 @@ func A000270(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000271
  */
-func A000271(seqlen int64) ([]*bint, int64) {
+func A000271(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		// a(n) = Sum_{k=0..n} (-1)^(n-k)*binomial(n+k,2*k)*k!
@@ -785,7 +896,7 @@ func A000271(seqlen int64) ([]*bint, int64) {
 		}
 		a[n] = sum
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -793,7 +904,7 @@ func A000271(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000272
  */
-func A000272(seqlen int64) ([]*bint, int64) {
+func A000272(seqlen int64) ([]*bint, int64, error) {
 	a := utils.InitBslice(seqlen,
 		[]*bint{inew(1), inew(1)})
 
@@ -807,7 +918,7 @@ func A000272(seqlen int64) ([]*bint, int64) {
 		}
 		a[n+1] = sum
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -816,9 +927,15 @@ func A000272(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000274
  */
-func A000274(seqlen int64) ([]*bint, int64) {
+func A000274(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000274", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
-	a166, _ := A000166(seqlen)
+	a166, _, err := A000166(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(2); n < seqlen; n++ {
 		if n%2 == 0 {
 			a[n] = mul(a166[n], inew((n+1)/2))
@@ -826,7 +943,7 @@ func A000274(seqlen int64) ([]*bint, int64) {
 			a[n] = div(mul(a166[n], inew(n)), inew(2))
 		}
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -835,7 +952,10 @@ func A000274(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000275
  */
-func A000275(seqlen int64) ([]*bint, int64) {
+func A000275(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000275", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	// a(n) = Sum_{r=0..n-1} (-1)^(r+n+1) * binomial(n, r)^2 * a(r)
 	a := iSlice(seqlen)
 	a[0] = inew(1)
@@ -849,7 +969,7 @@ func A000275(seqlen int64) ([]*bint, int64) {
 		}
 		a[n] = sum
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -857,13 +977,16 @@ func A000275(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000276
  */
-func A000276(seqlen int64) ([]*bint, int64) {
+func A000276(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
-	a254, _ := A000254(seqlen + 3)
+	a254, _, err := A000254(seqlen + 3)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(4); n <= seqlen+3; n++ {
 		a[n-4] = sub(sub(a254[n-1], fact(inew(n-1))), fact(inew(n-2)))
 	}
-	return a, 4
+	return a, 4, nil
 }
 
 /**
@@ -871,12 +994,12 @@ func A000276(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000277
  */
-func A000277(seqlen int64) ([]int64, int64) {
+func A000277(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = 3*n - 2*utils.Isqrt(4*n+5) + 5
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -885,14 +1008,14 @@ func A000277(seqlen int64) ([]int64, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000278
  */
-func A000278(seqlen int64) ([]*bint, int64) {
+func A000278(seqlen int64) ([]*bint, int64, error) {
 	a := utils.InitBslice(seqlen,
 		[]*bint{inew(0), inew(1)})
 
 	for n := int64(2); n < seqlen; n++ {
 		a[n] = add(a[n-1], pow(a[n-2], inew(2)))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -901,16 +1024,19 @@ func A000278(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000279
  */
-func A000279(seqlen int64) ([]*bint, int64) {
+func A000279(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
-	a172, _ := A000172(seqlen + 1)
+	a172, _, err := A000172(seqlen + 1)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(1); n <= seqlen; n++ {
 		//a(n) = n^2*(A000172(n)+4*A000172(n-1))/(n+1)
 		num := add(a172[n], mul(inew(4), a172[n-1]))
 		num = mul(pow(inew(n), inew(2)), num)
 		a[n-1] = floor(fdiv(itof(num), fnew(float64(n+1))))
 	}
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -918,14 +1044,14 @@ func A000279(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000280
  */
-func A000280(seqlen int64) ([]*bint, int64) {
+func A000280(seqlen int64) ([]*bint, int64, error) {
 	a := utils.InitBslice(seqlen,
 		[]*bint{inew(0), inew(1)})
 
 	for n := int64(2); n < seqlen; n++ {
 		a[n] = add(a[n-1], pow(a[n-2], inew(3)))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -934,14 +1060,14 @@ func A000280(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000283
  */
-func A000283(seqlen int64) ([]*bint, int64) {
+func A000283(seqlen int64) ([]*bint, int64, error) {
 	a := utils.InitBslice(seqlen,
 		[]*bint{inew(0), inew(1)})
 
 	for n := int64(2); n < seqlen; n++ {
 		a[n] = add(pow(a[n-1], inew(2)), pow(a[n-2], inew(2)))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -950,14 +1076,14 @@ func A000283(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000284
  */
-func A000284(seqlen int64) ([]*bint, int64) {
+func A000284(seqlen int64) ([]*bint, int64, error) {
 	a := utils.InitBslice(seqlen,
 		[]*bint{inew(0), inew(1)})
 
 	for n := int64(2); n < seqlen; n++ {
 		a[n] = add(a[n-2], pow(a[n-1], inew(3)))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -966,7 +1092,7 @@ func A000284(seqlen int64) ([]*bint, int64) {
  * Date		December 14, 2021
  * Link		https://oeis.org/A000285
  */
-func A000285(seqlen int64) ([]*bint, int64) {
+func A000285(seqlen int64) ([]*bint, int64, error) {
 	a := utils.InitBslice(seqlen,
 		[]*bint{inew(1), inew(4)})
 
@@ -974,7 +1100,7 @@ func A000285(seqlen int64) ([]*bint, int64) {
 	for n := int64(2); n < seqlen; n++ {
 		a[n] = add(a[n-1], a[n-2])
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -982,9 +1108,12 @@ func A000285(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000286
  */
-func A000286(seqlen int64) ([]*bint, int64) {
+func A000286(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000286", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := utils.Repr(seqlen, 2, 5, 0)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -992,7 +1121,7 @@ func A000286(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000287
  */
-func A000287(seqlen int64) ([]*bint, int64) {
+func A000287(seqlen int64) ([]*bint, int64, error) {
 	// b(n) = ( 2*(2*n)!/(n!)^2 - (27*n^2+9*n-2)*b(n-1) ) / (54*n^2-90*n+32)
 	b := iSlice(seqlen + 10)
 	b[0] = inew(2)
@@ -1011,11 +1140,10 @@ func A000287(seqlen int64) ([]*bint, int64) {
 	// a(n) = b(n-1) + 2*(-1)^n
 	a := utils.InitBslice(seqlen, []*bint{inew(1), inew(0), inew(4), inew(6)})
 	for n := int64(9); n <= seqlen+4; n++ {
-		fmt.Println("using", b[n-3], "for n =", n)
 		a[n-5] = add(b[n-3], mul(inew(2), pow(inew(-1), inew(n-5))))
 	}
 
-	return a, 6
+	return a, 6, nil
 }
 
 /**
@@ -1024,9 +1152,14 @@ func A000287(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000288
  */
-func A000288(seqlen int64) ([]*bint, int64) {
+func A000288(seqlen int64) ([]*bint, int64, error) {
 	a := utils.Nacci(seqlen, 4, false)
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000288 computes the single term a(n) from the recurrence
+func termA000288(n int64) (*bint, error) {
+	return utils.NacciRecurrence(4, false).Term(n), nil
 }
 
 /**
@@ -1034,12 +1167,12 @@ func A000288(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000289
  */
-func A000289(seqlen int64) ([]*bint, int64) {
+func A000289(seqlen int64) ([]*bint, int64, error) {
 	a := utils.InitBslice(seqlen, []*bint{inew(1), inew(4)})
 	for n := int64(2); n < seqlen; n++ {
 		a[n] = add(sub(pow(a[n-1], inew(2)), mul(inew(3), a[n-1])), inew(3))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -1047,9 +1180,14 @@ func A000289(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000290
  */
-func A000290(seqlen int64) ([]*bint, int64) {
+func A000290(seqlen int64) ([]*bint, int64, error) {
 	a := utils.Exponents(seqlen, inew(2))
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000290 computes the single term a(n) = n^2
+func termA000290(n int64) (*bint, error) {
+	return mul(inew(n), inew(n)), nil
 }
 
 /**
@@ -1057,14 +1195,20 @@ func A000290(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000291
  */
-func A000291(seqlen int64) ([]int64, int64) {
+func A000291(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	a70, _ := A000070(seqlen)
-	a97, _ := A000097(seqlen)
+	a70, _, err := A000070(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
+	a97, _, err := A000097(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = a70[n] + a97[n]
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -1073,12 +1217,12 @@ func A000291(seqlen int64) ([]int64, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000292
  */
-func A000292(seqlen int64) ([]int64, int64) {
+func A000292(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = n * (n + 1) * (n + 2) / 6
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -1086,7 +1230,10 @@ func A000292(seqlen int64) ([]int64, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000294
  */
-func A000294(seqlen int64) ([]*bint, int64) {
+func A000294(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000294", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	// a(n) = (1/(2*n))*Sum_{k=1..n} (sigma[2](k)+sigma[3](k))*a(n-k)
 	a := iSlice(seqlen)
 	a[0] = inew(1)
@@ -1098,7 +1245,7 @@ func A000294(seqlen int64) ([]*bint, int64) {
 		}
 		a[n] = floor(fmul(itof(sum), fdiv(fnew(1), fnew(2.0*nf))))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -1106,12 +1253,12 @@ func A000294(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000295
  */
-func A000295(seqlen int64) ([]*bint, int64) {
+func A000295(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = sub(pow(inew(2), inew(n)), inew(n+1))
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -1119,7 +1266,7 @@ func A000295(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/A000296
  */
-func A000296(seqlen int64) ([]*bint, int64) {
+func A000296(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		ksum := fnew(0)
@@ -1137,7 +1284,7 @@ func A000296(seqlen int64) ([]*bint, int64) {
 		}
 		a[n] = floor(ksum)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -1145,10 +1292,10 @@ func A000296(seqlen int64) ([]*bint, int64) {
  * Date		December 15, 2021
  * Link		https://oeis.org/
  */
-func A000297(seqlen int64) ([]int64, int64) {
+func A000297(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen-1; n++ {
 		a[n+1] = (n + 1) * (n + 3) * (n + 8) / 6
 	}
-	return a, -1
+	return a, -1, nil
 }
@@ -8,21 +8,104 @@
 package seq
 
 import (
+	"OEIS/transform"
 	"OEIS/utils"
-	"fmt"
+	"context"
 	"math"
 	"slices"
 	"strconv"
 	"strings"
 )
 
+// a(n) = 3a(n-1) + n + 1
+var recA000340 = utils.LinearRecurrence{Coeffs: []int64{3}, Init: []int64{1},
+	Extra: []utils.Inhomogeneous{{Poly: []int64{1, 1}, Base: 1}}}
+
+// registers every sequence in this file
+func init() {
+	registerBig("A000301", 0, A000301)
+	registerBig("A000302", 0, A000302)
+	registerBig("A000304", 0, A000304)
+	registerBig("A000308", 1, A000308)
+	registerBig("A000309", 0, A000309)
+	registerBig("A000312", 0, A000312)
+	registerBig("A000313", 1, A000313)
+	registerBig("A000317", 1, A000317)
+	registerBig("A000318", 1, A000318)
+	registerInt("A000319", 1, A000319)
+	registerBig("A000321", 0, A000321)
+	registerBig("A000322", 0, A000322)
+	registerBig("A000324", 0, A000324)
+	registerBig("A000325", 0, A000325)
+	registerInt("A000326", 0, A000326)
+	registerInt("A000327", 3, A000327)
+	registerInt("A000328", 0, A000328)
+	registerInt("A000329", 0, A000329)
+	registerInt("A000330", 0, A000330)
+	registerBig("A000332", 0, A000332)
+	registerBig("A000336", 0, A000336)
+	registerBig("A000337", 0, A000337)
+	registerInt("A000339", 2, A000339)
+	registerBig("A000340", 0, A000340)
+	registerBig("A000344", 2, A000344)
+	registerBig("A000346", 0, A000346)
+	registerInt("A000350", 1, A000350)
+	registerBig("A000351", 0, A000351)
+	registerBig("A000352", 4, A000352)
+	registerInt("A000353", 1, A000353)
+	registerBig("A000354", 0, A000354)
+	registerInt("A000355", 1, A000355)
+	registerBig("A000356", 1, A000356)
+	registerBig("A000358", 1, A000358)
+	registerBig("A000363", 4, A000363)
+	registerBig("A000371", 0, A000371)
+	registerBig("A000381", 0, A000381)
+	registerBig("A000383", 0, A000383)
+	registerInt("A000384", 0, A000384)
+	registerInt("A000385", 1, A000385)
+	registerBig("A000387", 0, A000387)
+	registerBig("A000389", 0, A000389)
+	registerBig("A000392", 0, A000392)
+	registerBig("A000396", 1, A000396)
+	registerBig("A000399", 3, A000399)
+	registerBig("A000400", 0, A000400)
+
+	// sequences that compute a(n) directly
+	registerTerm("A000302", termA000302)
+	registerTerm("A000322", termA000322)
+	registerTerm("A000332", termA000332)
+	registerTerm("A000340", termA000340)
+	registerTerm("A000351", termA000351)
+	registerTerm("A000383", termA000383)
+	registerTerm("A000389", termA000389)
+	registerTerm("A000392", termA000392)
+	registerTerm("A000400", termA000400)
+
+	// sequences that stream the terms they search for
+	registerStream("A000396", streamA000396)
+
+	// sequences that warn about how long they take, or how accurate they are
+	registerDiagnostics("A000319", func(seqlen int64) []utils.Diagnostic {
+		if seqlen <= 14 {
+			return nil
+		}
+		return []utils.Diagnostic{utils.AccuracyWarningWithLength("A000319", 14, "Due to the implementation of tan() in Go, this sequence is inaccurate for n > 14. More precision is necessary, but tan is not available for arbitrary precision floats.")}
+	})
+	registerDiagnostics("A000329", always(utils.AccuracyWarning("A000329")))
+	registerDiagnostics("A000396", always(utils.LongCalculationWarning("A000396")))
+	registerDiagnostics("A000399", always(utils.AccuracyWarning("A000399")))
+}
+
 /**
  * A000301 a(n) = a(n-1)*a(n-2) with a(0) = 1, a(1) = 2; also a(n) = 2^Fibonacci(n)
  * Date		2025.01.26
  * Link		https://oeis.org/A000301
  */
-func A000301(seqlen int64) ([]*bint, int64) {
-	fib, _ := A000045(seqlen)
+func A000301(seqlen int64) ([]*bint, int64, error) {
+	fib, _, err := A000045(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 
 	// compute a
@@ -30,7 +113,7 @@ func A000301(seqlen int64) ([]*bint, int64) {
 		a[n] = pow(inew(2), fib[n])
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -38,9 +121,14 @@ func A000301(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.26
  * Link		https://oeis.org/A000302
  */
-func A000302(seqlen int64) ([]*bint, int64) {
+func A000302(seqlen int64) ([]*bint, int64, error) {
 	a := utils.Powers(seqlen, inew(4))
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000302 computes the single term a(n) = 4^n
+func termA000302(n int64) (*bint, error) {
+	return pow(inew(4), inew(n)), nil
 }
 
 /**
@@ -48,7 +136,10 @@ func A000302(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.27
  * Link		https://oeis.org/A000304
  */
-func A000304(seqlen int64) ([]*bint, int64) {
+func A000304(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000304", seqlen, 2); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(2)
 	a[1] = inew(3)
@@ -58,7 +149,7 @@ func A000304(seqlen int64) ([]*bint, int64) {
 		a[n] = mul(a[n-1], a[n-2])
 	}
 
-	return a[:], 0
+	return a[:], 0, nil
 }
 
 /**
@@ -66,7 +157,10 @@ func A000304(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.27
  * Link		https://oeis.org/A000308
  */
-func A000308(seqlen int64) ([]*bint, int64) {
+func A000308(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000308", seqlen, 3); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
 	a[0] = inew(1)
 	a[1] = inew(2)
@@ -77,7 +171,7 @@ func A000308(seqlen int64) ([]*bint, int64) {
 		a[n] = mul(mul(a[n-1], a[n-2]), a[n-3])
 	}
 
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -85,9 +179,15 @@ func A000308(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.27
  * Link		https://oeis.org/A000309
  */
-func A000309(seqlen int64) ([]*bint, int64) {
+func A000309(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000309", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen)
-	a139, _ := A000139(seqlen)
+	a139, _, err := A000139(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 	a[0] = inew(1)
 
 	// compute a
@@ -95,7 +195,7 @@ func A000309(seqlen int64) ([]*bint, int64) {
 		a[n] = mul(pow(inew(2), inew(n-1)), a139[n])
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -103,7 +203,7 @@ func A000309(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.27
  * Link		https://oeis.org/A000312
  */
-func A000312(seqlen int64) ([]*bint, int64) {
+func A000312(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 
 	// compute a
@@ -111,7 +211,7 @@ func A000312(seqlen int64) ([]*bint, int64) {
 		a[n] = pow(inew(n), inew(n))
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -119,9 +219,12 @@ func A000312(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.27
  * Link		https://oeis.org/A000313
  */
-func A000313(seqlen int64) ([]*bint, int64) {
+func A000313(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
-	f, _ := A000142(seqlen + 2)
+	f, _, err := A000142(seqlen + 2)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	// compute a
 	for n := int64(0); n < seqlen; n++ {
@@ -135,7 +238,7 @@ func A000313(seqlen int64) ([]*bint, int64) {
 		a[n] = round(fmul(left, sum))
 	}
 
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -143,7 +246,10 @@ func A000313(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.27
  * Link		https://oeis.org/A000317
  */
-func A000317(seqlen int64) ([]*bint, int64) {
+func A000317(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000317", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 	a := iSlice(seqlen + 1)
 	a[0] = inew(1)
 	a[1] = inew(2)
@@ -153,7 +259,7 @@ func A000317(seqlen int64) ([]*bint, int64) {
 		a[n+1] = add(sub(pow(a[n], inew(2)), mul(a[n], a[n-1])), pow(a[n-1], inew(2)))
 	}
 
-	return a, 1
+	return a[:seqlen], 1, nil
 }
 
 /**
@@ -161,9 +267,12 @@ func A000317(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.27
  * Link		https://oeis.org/A000318
  */
-func A000318(seqlen int64) ([]*bint, int64) {
+func A000318(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
-	a182, _ := A000182(seqlen)
+	a182, _, err := A000182(seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	// compute a
 	for n := int64(1); n <= seqlen; n++ {
@@ -171,7 +280,7 @@ func A000318(seqlen int64) ([]*bint, int64) {
 		a[n-1] = mul(pow(inew(2), inew(4*n-2)), a182[n-1])
 	}
 
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -179,8 +288,7 @@ func A000318(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.27
  * Link		https://oeis.org/A000319
  */
-func A000319(seqlen int64) ([]int64, int64) {
-	utils.PrintWarning("Due to the implementation of tan() in Go, this sequence is inaccurate for n > 14. More precision is necessary, but tan is not available for arbitrary precision floats.")
+func A000319(seqlen int64) ([]int64, int64, error) {
 
 	// first generate b
 	b := make([]float64, seqlen+1)
@@ -195,7 +303,7 @@ func A000319(seqlen int64) ([]int64, int64) {
 		a[n] = int64(math.Floor(b[n]))
 	}
 
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -203,7 +311,10 @@ func A000319(seqlen int64) ([]int64, int64) {
  * Date		2025.01.30
  * Link		https://oeis.org/A000321
  */
-func A000321(seqlen int64) ([]*bint, int64) {
+func A000321(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000321", seqlen, 2); err != nil {
+		return nil, 0, err
+	}
 	// init
 	a := iSlice(seqlen)
 	a[0] = inew(1)
@@ -216,7 +327,7 @@ func A000321(seqlen int64) ([]*bint, int64) {
 		a[n] = sub(t1, t2)
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -224,22 +335,13 @@ func A000321(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.30
  * Link		https://oeis.org/A000322
  */
-func A000322(seqlen int64) ([]*bint, int64) {
-	// init
-	a := iSlice(seqlen)
-	a[0] = inew(1)
-	a[1] = inew(1)
-	a[2] = inew(1)
-	a[3] = inew(1)
-	a[4] = inew(1)
-
-	// compute a
-	for n := int64(5); n < seqlen; n++ {
-		// TODO: convert to addall
-		a[n] = add(add(add(add(a[n-1], a[n-2]), a[n-3]), a[n-4]), a[n-5])
-	}
+func A000322(seqlen int64) ([]*bint, int64, error) {
+	return utils.Nacci(seqlen, 5, false), 0, nil
+}
 
-	return a, 0
+// termA000322 computes the single term a(n) from the recurrence
+func termA000322(n int64) (*bint, error) {
+	return utils.NacciRecurrence(5, false).Term(n), nil
 }
 
 /**
@@ -247,7 +349,10 @@ func A000322(seqlen int64) ([]*bint, int64) {
  * Date		2025.01.30
  * Link		https://oeis.org/A000324
  */
-func A000324(seqlen int64) ([]*bint, int64) {
+func A000324(seqlen int64) ([]*bint, int64, error) {
+	if err := checkLen("A000324", seqlen, 2); err != nil {
+		return nil, 0, err
+	}
 	// init
 	a := iSlice(seqlen)
 	a[0] = inew(1)
@@ -258,7 +363,7 @@ func A000324(seqlen int64) ([]*bint, int64) {
 		a[n] = add(sub(pow(a[n-1], inew(2)), mul(inew(4), a[n-1])), inew(4))
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -266,14 +371,14 @@ func A000324(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000325
  */
-func A000325(seqlen int64) ([]*bint, int64) {
+func A000325(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = sub(pow(inew(2), inew(n)), inew(n))
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -281,14 +386,14 @@ func A000325(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000326
  */
-func A000326(seqlen int64) ([]int64, int64) {
+func A000326(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = n * (3*n - 1) / 2
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -296,16 +401,19 @@ func A000326(seqlen int64) ([]int64, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000327
  */
-func A000327(seqlen int64) ([]int64, int64) {
+func A000327(seqlen int64) ([]int64, int64, error) {
 	offset := int64(3)
-	a := make([]int64, seqlen+offset)
-	a148, a148_off := A000148(seqlen + offset)
+	a := make([]int64, seqlen)
+	a148, a148_off, err := A000148(seqlen + offset)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	for n := int64(3); n < seqlen+offset; n++ {
 		a[n-offset] = a148[n-a148_off] - int64(math.Floor(math.Pow(float64(n)/2.0, 3.0/2.0)))
 	}
 
-	return a, 0
+	return a, offset, nil
 }
 
 /**
@@ -313,7 +421,7 @@ func A000327(seqlen int64) ([]int64, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000328
  */
-func A000328(seqlen int64) ([]int64, int64) {
+func A000328(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 
 	for n := int64(0); n < seqlen; n++ {
@@ -332,7 +440,7 @@ func A000328(seqlen int64) ([]int64, int64) {
 		a[n] = 1 + 4*sum
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -340,8 +448,10 @@ func A000328(seqlen int64) ([]int64, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000329
  */
-func A000329(seqlen int64) ([]int64, int64) {
-	utils.AccuracyWarning("A000329")
+func A000329(seqlen int64) ([]int64, int64, error) {
+	if err := checkLen("A000329", seqlen, 1); err != nil {
+		return nil, 0, err
+	}
 
 	a := make([]int64, seqlen)
 	b := make([]float64, seqlen)
@@ -352,7 +462,7 @@ func A000329(seqlen int64) ([]int64, int64) {
 		a[n] = int64(math.Round(b[n]))
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -360,14 +470,14 @@ func A000329(seqlen int64) ([]int64, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000330
  */
-func A000330(seqlen int64) ([]int64, int64) {
+func A000330(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = n * (n + 1) * (2*n + 1) / 6
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -375,14 +485,19 @@ func A000330(seqlen int64) ([]int64, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000332
  */
-func A000332(seqlen int64) ([]*bint, int64) {
+func A000332(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = nCr(inew(n), inew(4))
 	}
 
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000332 computes the single term a(n) = C(n, 4)
+func termA000332(n int64) (*bint, error) {
+	return nCr(inew(n), inew(4)), nil
 }
 
 /**
@@ -390,7 +505,7 @@ func A000332(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000336
  */
-func A000336(seqlen int64) ([]*bint, int64) {
+func A000336(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 
 	for n := int64(0); n < seqlen; n++ {
@@ -401,7 +516,7 @@ func A000336(seqlen int64) ([]*bint, int64) {
 		}
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -409,7 +524,7 @@ func A000336(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.08
  * Link		https://oeis.org/A000337
  */
-func A000337(seqlen int64) ([]*bint, int64) {
+func A000337(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 
 	for n := int64(0); n < seqlen; n++ {
@@ -418,7 +533,7 @@ func A000337(seqlen int64) ([]*bint, int64) {
 		a[n] = add(mul(n1, twon), inew(1))
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -426,7 +541,7 @@ func A000337(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000339
  */
-func A000339(seqlen int64) ([]int64, int64) {
+func A000339(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	offset := int64(2)
 
@@ -444,7 +559,7 @@ func A000339(seqlen int64) ([]int64, int64) {
 		a[n-offset] = sum
 	}
 
-	return a, offset
+	return a, offset, nil
 }
 
 /**
@@ -452,15 +567,13 @@ func A000339(seqlen int64) ([]int64, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000340
  */
-func A000340(seqlen int64) ([]int64, int64) {
-	a := make([]int64, seqlen)
-	a[0] = 1
-
-	for n := int64(1); n < seqlen; n++ {
-		a[n] = 3*a[n-1] + n + 1
-	}
+func A000340(seqlen int64) ([]*bint, int64, error) {
+	return recA000340.Terms(seqlen), 0, nil
+}
 
-	return a, 0
+// termA000340 computes the single term a(n) from the recurrence
+func termA000340(n int64) (*bint, error) {
+	return recA000340.Term(n), nil
 }
 
 /**
@@ -468,7 +581,7 @@ func A000340(seqlen int64) ([]int64, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000344
  */
-func A000344(seqlen int64) ([]*bint, int64) {
+func A000344(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	offset := int64(2)
 
@@ -479,7 +592,7 @@ func A000344(seqlen int64) ([]*bint, int64) {
 		a[n-offset] = div(mul(inew(5), binom), add(nb, inew(3)))
 	}
 
-	return a, offset
+	return a, offset, nil
 }
 
 /**
@@ -487,7 +600,7 @@ func A000344(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000346
  */
-func A000346(seqlen int64) ([]*bint, int64) {
+func A000346(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 
 	for n := int64(0); n < seqlen; n++ {
@@ -496,7 +609,7 @@ func A000346(seqlen int64) ([]*bint, int64) {
 		a[n] = sub(pow(inew(2), twonplus1), nCr(twonplus1, add(nb, inew(1))))
 	}
 
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -504,9 +617,12 @@ func A000346(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000350
  */
-func A000350(seqlen int64) ([]int64, int64) {
+func A000350(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
-	fib, _ := A000045(seqlen * seqlen)
+	fib, _, err := A000045(seqlen * seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	n := int64(0)
 	for m := int64(0); n < seqlen; m++ {
@@ -523,7 +639,7 @@ func A000350(seqlen int64) ([]int64, int64) {
 		}
 	}
 
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -531,9 +647,14 @@ func A000350(seqlen int64) ([]int64, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000351
  */
-func A000351(seqlen int64) ([]*bint, int64) {
+func A000351(seqlen int64) ([]*bint, int64, error) {
 	a := utils.Powers(seqlen, inew(5))
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000351 computes the single term a(n) = 5^n
+func termA000351(n int64) (*bint, error) {
+	return pow(inew(5), inew(n)), nil
 }
 
 /**
@@ -542,7 +663,7 @@ func A000351(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000352
 */
-func A000352(seqlen int64) ([]*bint, int64) {
+func A000352(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	offset := int64(4)
 
@@ -554,7 +675,7 @@ func A000352(seqlen int64) ([]*bint, int64) {
 		a[n-offset] = div(add(suball(three_n, fourpow, twon), inew(11)), inew(4))
 	}
 
-	return a, offset
+	return a, offset, nil
 }
 
 /**
@@ -562,7 +683,7 @@ func A000352(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000353
  */
-func A000353(seqlen int64) ([]int64, int64) {
+func A000353(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	primes := []int64{7, 19, 23}
 	const MODVAL = 40
@@ -577,7 +698,7 @@ func A000353(seqlen int64) ([]int64, int64) {
 		}
 	}
 
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -585,24 +706,11 @@ func A000353(seqlen int64) ([]int64, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000354
  */
-func A000354(seqlen int64) ([]*bint, int64) {
-	a := iSlice(seqlen)
-
-	for n := int64(0); n < seqlen; n++ {
-		nb := inew(n)
-		sum := inew(0)
-		for k := int64(0); k <= n; k++ {
-			kb := inew(k)
-			pow1 := pow(inew(-1), add(nb, kb)) // (-1)^(n+k)
-			binom := nCr(nb, kb)
-			kfact := fact(kb)
-			twok := pow(inew(2), kb)
-			sum = add(sum, mulall(pow1, binom, kfact, twok))
-		}
-		a[n] = sum
-	}
-
-	return a, 0
+func A000354(seqlen int64) ([]*bint, int64, error) {
+	// exp(-x) / (1-2x)
+	num := utils.Monomial(seqlen, rnew(-1, 1), 1).Exp()
+	a, err := num.Div(utils.NewSeries(seqlen, 1, -2)).Exponential()
+	return a, 0, err
 }
 
 /**
@@ -610,7 +718,7 @@ func A000354(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000355
  */
-func A000355(seqlen int64) ([]int64, int64) {
+func A000355(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	primes := []int64{3, 9, 11}
 	const MODVAL = 20
@@ -625,7 +733,7 @@ func A000355(seqlen int64) ([]int64, int64) {
 		}
 	}
 
-	return a, 1
+	return a, 1, nil
 }
 
 /**
@@ -634,7 +742,7 @@ func A000355(seqlen int64) ([]int64, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000356
  */
-func A000356(seqlen int64) ([]*bint, int64) {
+func A000356(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	offset := int64(1)
 
@@ -649,7 +757,7 @@ func A000356(seqlen int64) ([]*bint, int64) {
 		a[n-offset] = div(numer, denom)
 	}
 
-	return a, offset
+	return a, offset, nil
 }
 
 /**
@@ -658,10 +766,13 @@ func A000356(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000358
  */
-func A000358(seqlen int64) ([]*bint, int64) {
+func A000358(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	offset := int64(1)
-	fib, _ := A000045(seqlen + offset*2)
+	fib, _, err := A000045(seqlen + offset*2)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	for n := offset; n <= seqlen; n++ {
 		nb := inew(n)
@@ -676,7 +787,7 @@ func A000358(seqlen int64) ([]*bint, int64) {
 		a[n-offset] = div(sum, nb)
 	}
 
-	return a, offset
+	return a, offset, nil
 }
 
 /**
@@ -685,7 +796,7 @@ func A000358(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000363
  */
-func A000363(seqlen int64) ([]*bint, int64) {
+func A000363(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	offset := int64(4)
 
@@ -700,7 +811,7 @@ func A000363(seqlen int64) ([]*bint, int64) {
 		a[n-offset] = div(numer, inew(16))
 	}
 
-	return a, offset
+	return a, offset, nil
 }
 
 /**
@@ -708,7 +819,7 @@ func A000363(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000371
  */
-func A000371(seqlen int64) ([]*bint, int64) {
+func A000371(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	offset := int64(0)
 
@@ -725,7 +836,7 @@ func A000371(seqlen int64) ([]*bint, int64) {
 		a[n] = sum
 	}
 
-	return a, offset
+	return a, offset, nil
 }
 
 /**
@@ -733,10 +844,13 @@ func A000371(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000381
  */
-func A000381(seqlen int64) ([]*bint, int64) {
-	a1611, _ := A001611(seqlen + 2)
+func A000381(seqlen int64) ([]*bint, int64, error) {
+	a1611, _, err := A001611(seqlen + 2)
+	if err != nil {
+		return nil, 0, err
+	}
 	a := utils.ShiftBigSliceLeft(a1611, 2)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -744,9 +858,14 @@ func A000381(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000383
  */
-func A000383(seqlen int64) ([]*bint, int64) {
+func A000383(seqlen int64) ([]*bint, int64, error) {
 	a := utils.Nacci(seqlen, 6, false)
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000383 computes the single term a(n) from the recurrence
+func termA000383(n int64) (*bint, error) {
+	return utils.NacciRecurrence(6, false).Term(n), nil
 }
 
 /**
@@ -754,12 +873,12 @@ func A000383(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000384
  */
-func A000384(seqlen int64) ([]int64, int64) {
+func A000384(seqlen int64) ([]int64, int64, error) {
 	a := make([]int64, seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = n * (2*n - 1)
 	}
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -767,18 +886,13 @@ func A000384(seqlen int64) ([]int64, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000385
  */
-func A000385(seqlen int64) ([]int64, int64) {
-	a := make([]int64, seqlen)
-	a203, offset203 := A000203(seqlen + 1)
-	offset := int64(1)
-	for n := offset; n < seqlen+offset; n++ {
-		sum := int64(0)
-		for k := int64(1); k <= n; k++ {
-			sum += a203[k-offset203] * a203[n-k+1-offset203]
-		}
-		a[n-offset] = sum
+func A000385(seqlen int64) ([]int64, int64, error) {
+	a203, _, err := A000203(seqlen)
+	if err != nil {
+		return nil, 0, err
 	}
-	return a, offset
+	sigma := utils.ToBigSlice(a203)
+	return utils.ToIntSlice(transform.Convolution(sigma, sigma)), 1, nil
 }
 
 /**
@@ -787,9 +901,9 @@ func A000385(seqlen int64) ([]int64, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000387
  */
-func A000387(seqlen int64) ([]*bint, int64) {
+func A000387(seqlen int64) ([]*bint, int64, error) {
 	a := utils.Recontres(seqlen, 2)
-	return a, 0
+	return a, 0, nil
 }
 
 /**
@@ -798,13 +912,18 @@ func A000387(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000389
  */
-func A000389(seqlen int64) ([]*bint, int64) {
+func A000389(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	big5 := inew(5)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = nCr(inew(n), big5)
 	}
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000389 computes the single term a(n) = C(n, 5)
+func termA000389(n int64) (*bint, error) {
+	return nCr(inew(n), inew(5)), nil
 }
 
 /**
@@ -812,12 +931,17 @@ func A000389(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000392
  */
-func A000392(seqlen int64) ([]*bint, int64) {
+func A000392(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	for n := int64(0); n < seqlen; n++ {
 		a[n] = utils.Stirling2(n, 3)
 	}
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000392 computes the single term a(n) = S(n, 3)
+func termA000392(n int64) (*bint, error) {
+	return utils.Stirling2(n, 3), nil
 }
 
 /**
@@ -825,22 +949,30 @@ func A000392(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000396
  */
-func A000396(seqlen int64) ([]*bint, int64) {
-	utils.LongCalculationWarning("A000396")
-	offset := int64(1)
-	a := iSlice(seqlen)
+func A000396(seqlen int64) ([]*bint, int64, error) {
+	a, err := collectBig(streamA000396, seqlen)
+	if err != nil {
+		return nil, 0, err
+	}
+	return a, 1, nil
+}
+
+// streamA000396 yields the perfect numbers as they are found
+func streamA000396(ctx context.Context, seqlen int64, yield Yield) error {
 	n := int64(0)
-	for k := offset; n < seqlen; k++ {
-		kb := inew(k)
-		divs := utils.FactorsBig(kb, false)
-		sumdivs := utils.SumBig(divs)
-		if equals(sumdivs, kb) {
-			a[n] = kb
-			fmt.Println(a)
+	for k := int64(1); n < seqlen; k++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// k is perfect when the sum of all its divisors is 2k
+		if equals(utils.Sigma(k, 1), inew(2*k)) {
 			n++
+			if !yield(n, inew(k)) {
+				return nil
+			}
 		}
 	}
-	return a, offset
+	return nil
 }
 
 /**
@@ -848,8 +980,7 @@ func A000396(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000399
  */
-func A000399(seqlen int64) ([]*bint, int64) {
-	utils.AccuracyWarning("A000399")
+func A000399(seqlen int64) ([]*bint, int64, error) {
 	a := iSlice(seqlen)
 	offset := int64(3)
 
@@ -857,7 +988,7 @@ func A000399(seqlen int64) ([]*bint, int64) {
 		a[n-offset] = utils.Stirling1(n, offset)
 	}
 
-	return a, offset
+	return a, offset, nil
 }
 
 /**
@@ -865,7 +996,12 @@ func A000399(seqlen int64) ([]*bint, int64) {
  * Date		2025.02.09
  * Link		https://oeis.org/A000399
  */
-func A000400(seqlen int64) ([]*bint, int64) {
+func A000400(seqlen int64) ([]*bint, int64, error) {
 	a := utils.Powers(seqlen, inew(6))
-	return a, 0
+	return a, 0, nil
+}
+
+// termA000400 computes the single term a(n) = 6^n
+func termA000400(n int64) (*bint, error) {
+	return pow(inew(6), inew(n)), nil
 }
@@ -0,0 +1,43 @@
+// ============================================================================
+// = thru800.go
+// = 	Description		OEIS sequences from A000701-A000800
+// = 	Note			Not all sequences in this range have been programmed
+// = 	Date 			2026.10.17
+// ============================================================================
+
+package seq
+
+import (
+	"OEIS/utils"
+)
+
+// registers every sequence in this file
+func init() {
+	registerInt("A000720", 1, A000720)
+
+	// sequences that compute a(n) directly
+	registerTerm("A000720", termA000720)
+}
+
+/**
+ * A000720 computes pi(n), the number of primes <= n
+ * Date		2026.10.17
+ * Link		https://oeis.org/A000720
+ */
+func A000720(seqlen int64) ([]int64, int64, error) {
+	a := make([]int64, seqlen)
+	primes := utils.PrimesUpTo(seqlen)
+	count := int64(0)
+	for n := int64(1); n <= seqlen; n++ {
+		if count < int64(len(primes)) && primes[count] == n {
+			count++
+		}
+		a[n-1] = count
+	}
+	return a, 1, nil
+}
+
+// termA000720 computes the single term a(n) = pi(n) without listing the primes
+func termA000720(n int64) (*bint, error) {
+	return inew(utils.PrimePi(n)), nil
+}
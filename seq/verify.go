@@ -0,0 +1,161 @@
+// ============================================================================
+// = verify.go
+// = 	Description		Checks the terms of a sequence against reference data
+// = 	Note			The reference is usually the OEIS stripped file or a b-file
+// = 	Date			2026.10.17
+// ============================================================================
+
+package seq
+
+import (
+	"context"
+	"time"
+)
+
+// ############################### VERIFYING ################################
+// ### a sequence is generated with a timeout and its terms are compared with
+// ### the reference, term by term, from the first term of each.
+
+// Status is the outcome of verifying a sequence
+type Status int
+
+const (
+	Pass    Status = iota // every term compared agrees with the reference
+	Fail                  // a term or the offset disagrees with the reference
+	TooSlow               // ran out of time; the terms it found agree
+	NoData                // there is no reference to compare with
+	Errored               // the sequence returned an error before any term, or the reference couldn't be read
+)
+
+// String returns the name of the status
+func (s Status) String() string {
+	switch s {
+	case Fail:
+		return "fail"
+	case TooSlow:
+		return "slow"
+	case NoData:
+		return "no data"
+	case Errored:
+		return "error"
+	}
+	return "pass"
+}
+
+// Reference holds the known terms of a sequence to verify it against
+type Reference struct {
+	Terms     []*bint
+	Offset    int64 // the index of Terms[0], if HasOffset
+	HasOffset bool  // b-files have an offset; the OEIS stripped file doesn't
+	Err       error // why the reference couldn't be read, if it couldn't
+}
+
+// Report is the result of verifying a sequence
+type Report struct {
+	Seq     Sequence
+	Status  Status
+	Checked int   // the number of terms compared
+	N       int64 // the index of the first mismatching term, if any
+	Got     *bint // a(N) as computed, if a term mismatched
+	Want    *bint // a(N) according to the reference, if a term mismatched
+	Offset  bool  // whether the offset disagrees with the reference
+	Shift   int   // if nonzero, the terms agree once the reference is shifted by this many places
+	Err     error // the error, if Status == Errored
+}
+
+// the fewest terms that must agree before a shift is reported
+const minShifted = 5
+
+// Verify generates up to seqlen terms of s, or as many as ref has, and compares
+// them with ref. If s takes longer than timeout, the terms found so far are
+// compared and the status is TooSlow unless one of them disagrees.
+func Verify(ctx context.Context, s Sequence, ref Reference, seqlen int64, timeout time.Duration) (r Report) {
+	r.Seq = s
+	if ref.Err != nil {
+		r.Status, r.Err = Errored, ref.Err
+		return r
+	}
+	if len(ref.Terms) == 0 {
+		r.Status = NoData
+		return r
+	}
+	if int64(len(ref.Terms)) < seqlen {
+		seqlen = int64(len(ref.Terms))
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			r.Status, r.Err = Errored, panicError(s, p)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	got, late, err := growPrefix(ctx, s, 10, seqlen, nil)
+	if len(got) == 0 && err != nil {
+		r.Status, r.Err = Errored, err
+		return r
+	}
+
+	r.Offset = ref.HasOffset && ref.Offset != s.Offset()
+	r.Checked = len(got)
+	if len(ref.Terms) < r.Checked {
+		r.Checked = len(ref.Terms)
+	}
+	for i := 0; i < r.Checked; i++ {
+		if got[i].Cmp(ref.Terms[i]) != 0 {
+			r.Status = Fail
+			r.N, r.Got, r.Want = s.Offset()+int64(i), got[i], ref.Terms[i]
+			r.Shift = shift(got, ref.Terms)
+			return r
+		}
+	}
+
+	switch {
+	case r.Offset:
+		r.Status = Fail
+	case late:
+		r.Status = TooSlow
+	default:
+		r.Status = Pass
+	}
+	return r
+}
+
+// VerifyAll verifies every sequence in seqs against the Reference that ref
+// returns for its ID, several at a time, so ref must be safe to call
+// concurrently. The reports are in the order of seqs.
+func VerifyAll(ctx context.Context, seqs []Sequence, ref func(id string) Reference, seqlen int64, timeout time.Duration) []Report {
+	reports := make([]Report, len(seqs))
+	index := make(map[string]int, len(seqs))
+	for i, s := range seqs {
+		index[s.ID()] = i
+	}
+	concurrently(seqs, func(s Sequence) {
+		reports[index[s.ID()]] = Verify(ctx, s, ref(s.ID()), seqlen, timeout)
+	})
+	return reports
+}
+
+// returns the k, between -3 and 3, for which a[i] = ref[i+k] for every i both
+// have, or 0 if there is none. A positive k means ref has k more leading terms.
+func shift(a, ref []*bint) int {
+	for _, k := range []int{1, -1, 2, -2, 3, -3} {
+		if k >= len(ref) || -k >= len(a) {
+			continue
+		}
+		x, y := a, ref
+		if k > 0 {
+			y = ref[k:]
+		} else {
+			x = a[-k:]
+		}
+		n := len(x)
+		if len(y) < n {
+			n = len(y)
+		}
+		if n >= minShifted && equalTerms(x[:n], y[:n]) {
+			return k
+		}
+	}
+	return 0
+}
@@ -0,0 +1,46 @@
+package seq
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestVerify checks the Fibonacci numbers against references that agree,
+// disagree in a term, are shifted by a term, disagree in the offset, don't
+// exist and couldn't be read
+func TestVerify(t *testing.T) {
+	s, _ := Lookup("A000045")
+	fib := make([]*bint, 0)
+	for _, v := range []int64{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89} {
+		fib = append(fib, inew(v))
+	}
+	changed := append([]*bint{}, fib...)
+	changed[7] = inew(14)
+
+	tests := []struct {
+		name    string
+		ref     Reference
+		status  Status
+		checked int
+		n       int64
+		shift   int
+		offset  bool
+	}{
+		{"agrees", Reference{Terms: fib}, Pass, len(fib), 0, 0, false},
+		{"agrees with offset", Reference{Terms: fib, Offset: 0, HasOffset: true}, Pass, len(fib), 0, 0, false},
+		{"changed term", Reference{Terms: changed}, Fail, len(fib), 7, 0, false},
+		{"shifted", Reference{Terms: fib[1:]}, Fail, len(fib) - 1, 0, -1, false},
+		{"offset", Reference{Terms: fib, Offset: 1, HasOffset: true}, Fail, len(fib), 0, 0, true},
+		{"no data", Reference{}, NoData, 0, 0, 0, false},
+		{"unreadable", Reference{Err: errors.New("b000045.txt: bad line")}, Errored, 0, 0, 0, false},
+	}
+	for _, tt := range tests {
+		r := Verify(context.Background(), s, tt.ref, 40, 5*time.Second)
+		if r.Status != tt.status || r.Checked != tt.checked || r.N != tt.n || r.Shift != tt.shift || r.Offset != tt.offset {
+			t.Errorf("%s: got %v, %d checked, N = %d, shift %d, offset %v; want %v, %d, %d, %d, %v", tt.name,
+				r.Status, r.Checked, r.N, r.Shift, r.Offset, tt.status, tt.checked, tt.n, tt.shift, tt.offset)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+// ============================================================================
+// = transform.go
+// = 	Description		Transforms that build one sequence from another
+// = 	Note			See https://oeis.org/transforms.html for the
+// = 					definitions. Every transform is exact
+// = 	Date			2026.10.17
+// ============================================================================
+
+package transform
+
+import (
+	"OEIS/utils"
+	"errors"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type bint = big.Int
+
+// Func transforms the first terms of a sequence into as many terms of
+// another as they determine
+type Func func(a []*bint) []*bint
+
+// the transforms that take a single sequence, by the name -transform uses
+var named = map[string]Func{
+	"binomial":      Binomial,
+	"ibinomial":     InverseBinomial,
+	"euler":         Euler,
+	"ieuler":        InverseEuler,
+	"mobius":        Mobius,
+	"imobius":       InverseMobius,
+	"stirling":      Stirling,
+	"istirling":     InverseStirling,
+	"boustrophedon": Boustrophedon,
+	"psum":          PartialSums,
+	"diff":          Differences,
+	"conv":          func(a []*bint) []*bint { return Convolution(a, a) },
+	"dirichlet":     func(a []*bint) []*bint { return DirichletConvolution(a, a) },
+}
+
+// the transforms that work on divisors, and so take a(1), a(2), ...
+var fromOne = map[string]bool{
+	"euler":     true,
+	"ieuler":    true,
+	"mobius":    true,
+	"imobius":   true,
+	"dirichlet": true,
+}
+
+// FromOne reports whether the transform with the given name works on
+// divisors: its input must start at a(1), and its output starts at b(1)
+func FromOne(name string) bool {
+	return fromOne[name]
+}
+
+// Names returns the names Lookup accepts, sorted
+func Names() []string {
+	names := []string{"section:k:r"}
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the transform with the given name: one of Names, where
+// conv and dirichlet convolve a sequence with itself, and section:k:r takes
+// every kth term starting at the rth
+func Lookup(name string) (Func, error) {
+	if f, ok := named[name]; ok {
+		return f, nil
+	}
+	if strings.HasPrefix(name, "section:") {
+		parts := strings.Split(name, ":")
+		if len(parts) == 3 {
+			k, err1 := strconv.Atoi(parts[1])
+			r, err2 := strconv.Atoi(parts[2])
+			if err1 == nil && err2 == nil && k > 0 && r >= 0 {
+				return func(a []*bint) []*bint { return Section(a, k, r) }, nil
+			}
+		}
+		return nil, errors.New("expected section:k:r with k > 0 and r >= 0, got " + strconv.Quote(name))
+	}
+	return nil, errors.New("unknown transform " + strconv.Quote(name) + "; use one of " + strings.Join(Names(), ", "))
+}
+
+// returns n zeros
+func zeros(n int) []*bint {
+	a := make([]*bint, n)
+	for i := range a {
+		a[i] = new(bint)
+	}
+	return a
+}
+
+// ########################### BINOMIAL & STIRLING ##########################
+// ### these transforms multiply by a triangle of numbers, a(0) first:
+// ### b(n) = Sum_{k=0..n} T(n,k) a(k)
+
+// Binomial returns b(n) = Sum_k C(n,k) a(k)
+func Binomial(a []*bint) []*bint {
+	return binomial(a, false)
+}
+
+// InverseBinomial returns b(n) = Sum_k (-1)^(n-k) C(n,k) a(k), which
+// undoes Binomial
+func InverseBinomial(a []*bint) []*bint {
+	return binomial(a, true)
+}
+
+func binomial(a []*bint, alternate bool) []*bint {
+	b := zeros(len(a))
+	c, term := new(bint), new(bint)
+	for n := range a {
+		c.SetInt64(1) // C(n,k), from k = 0
+		for k := 0; k <= n; k++ {
+			term.Mul(c, a[k])
+			if alternate && (n-k)%2 == 1 {
+				term.Neg(term)
+			}
+			b[n].Add(b[n], term)
+			c.Mul(c, big.NewInt(int64(n-k)))
+			c.Quo(c, big.NewInt(int64(k+1)))
+		}
+	}
+	return b
+}
+
+// Stirling returns b(n) = Sum_k S2(n,k) a(k), with the Stirling numbers of
+// the second kind
+func Stirling(a []*bint) []*bint {
+	// S2(n,k) = k S2(n-1,k) + S2(n-1,k-1)
+	return triangle(a, func(n, k int64) int64 { return k })
+}
+
+// InverseStirling returns b(n) = Sum_k s(n,k) a(k), with the signed
+// Stirling numbers of the first kind. It undoes Stirling.
+func InverseStirling(a []*bint) []*bint {
+	// s(n,k) = s(n-1,k-1) - (n-1) s(n-1,k)
+	return triangle(a, func(n, k int64) int64 { return -(n - 1) })
+}
+
+// applies the triangle with T(0,0) = 1 and T(n,k) = m(n,k) T(n-1,k) +
+// T(n-1,k-1), one row at a time
+func triangle(a []*bint, m func(n, k int64) int64) []*bint {
+	b := zeros(len(a))
+	row := []*bint{big.NewInt(1)}
+	term := new(bint)
+	for n := range a {
+		if n > 0 {
+			next := zeros(n + 1)
+			for k := 0; k <= n; k++ {
+				if k < n {
+					next[k].Mul(row[k], big.NewInt(m(int64(n), int64(k))))
+				}
+				if k > 0 {
+					next[k].Add(next[k], row[k-1])
+				}
+			}
+			row = next
+		}
+		for k := 0; k <= n; k++ {
+			b[n].Add(b[n], term.Mul(row[k], a[k]))
+		}
+	}
+	return b
+}
+
+// Boustrophedon returns the boustrophedon transform: the last entries of
+// the rows of T(n,0) = a(n), T(n,k) = T(n,k-1) + T(n-1,n-k), which is
+// b(n) = Sum_k C(n,k) a(k) E(n-k) with the Euler zigzag numbers E
+func Boustrophedon(a []*bint) []*bint {
+	b := zeros(len(a))
+	row := []*bint{}
+	for n := range a {
+		next := zeros(n + 1)
+		next[0].Set(a[n])
+		for k := 1; k <= n; k++ {
+			next[k].Add(next[k-1], row[n-k])
+		}
+		row = next
+		b[n].Set(row[n])
+	}
+	return b
+}
+
+// ############################ EULER & MOBIUS ##############################
+// ### these transforms are about divisors, so the sequences start at a(1):
+// ### a[0] is a(1), and the result is b(1), b(2), ...
+
+// Euler returns the Euler transform: 1 + Sum_n b(n) x^n =
+// Prod_n 1/(1-x^n)^a(n). If a(n) counts the objects of size n of some
+// kind, b(n) counts the multisets of them of total size n.
+func Euler(a []*bint) []*bint {
+	// with c(n) = Sum_{d|n} d a(d), n b(n) = Sum_{k=1..n} c(k) b(n-k)
+	n := len(a)
+	c := zeros(n + 1)
+	term := new(bint)
+	for d := 1; d <= n; d++ {
+		term.Mul(big.NewInt(int64(d)), a[d-1])
+		for m := d; m <= n; m += d {
+			c[m].Add(c[m], term)
+		}
+	}
+	b := zeros(n + 1)
+	b[0].SetInt64(1)
+	for i := 1; i <= n; i++ {
+		for k := 1; k <= i; k++ {
+			b[i].Add(b[i], term.Mul(c[k], b[i-k]))
+		}
+		b[i].Quo(b[i], big.NewInt(int64(i)))
+	}
+	return b[1:]
+}
+
+// InverseEuler returns the a whose Euler transform is b, where b(0) = 1
+func InverseEuler(b []*bint) []*bint {
+	// c(n) = n b(n) - Sum_{k=1..n-1} c(k) b(n-k), then Mobius inversion of
+	// c(n) = Sum_{d|n} d a(d)
+	n := len(b)
+	bs := append([]*bint{big.NewInt(1)}, b...)
+	c := zeros(n + 1)
+	term := new(bint)
+	for i := 1; i <= n; i++ {
+		c[i].Mul(big.NewInt(int64(i)), bs[i])
+		for k := 1; k < i; k++ {
+			c[i].Sub(c[i], term.Mul(c[k], bs[i-k]))
+		}
+	}
+	a := Mobius(c[1:])
+	for i := range a {
+		a[i].Quo(a[i], big.NewInt(int64(i+1)))
+	}
+	return a
+}
+
+// Mobius returns b(n) = Sum_{d|n} mu(n/d) a(d)
+func Mobius(a []*bint) []*bint {
+	mu := utils.Mobius.Table(int64(len(a)))
+	b := zeros(len(a))
+	term := new(bint)
+	for d := 1; d <= len(a); d++ {
+		for m := d; m <= len(a); m += d {
+			if mu[m/d] != 0 {
+				b[m-1].Add(b[m-1], term.Mul(big.NewInt(mu[m/d]), a[d-1]))
+			}
+		}
+	}
+	return b
+}
+
+// InverseMobius returns b(n) = Sum_{d|n} a(d), which undoes Mobius
+func InverseMobius(a []*bint) []*bint {
+	b := zeros(len(a))
+	for d := 1; d <= len(a); d++ {
+		for m := d; m <= len(a); m += d {
+			b[m-1].Add(b[m-1], a[d-1])
+		}
+	}
+	return b
+}
+
+// ############################# CONVOLUTIONS ###############################
+
+// Convolution returns c(n) = Sum_{k=0..n} a(k) b(n-k), the coefficients of
+// the product of the ordinary g.f.s, as far as both are known
+func Convolution(a, b []*bint) []*bint {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	c := zeros(n)
+	term := new(bint)
+	for i := 0; i < n; i++ {
+		for k := 0; k <= i; k++ {
+			c[i].Add(c[i], term.Mul(a[k], b[i-k]))
+		}
+	}
+	return c
+}
+
+// DirichletConvolution returns c(n) = Sum_{d|n} a(d) b(n/d), where a[0]
+// is a(1), as far as both are known
+func DirichletConvolution(a, b []*bint) []*bint {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	c := zeros(n)
+	term := new(bint)
+	for d := 1; d <= n; d++ {
+		for m := d; m <= n; m += d {
+			c[m-1].Add(c[m-1], term.Mul(a[d-1], b[m/d-1]))
+		}
+	}
+	return c
+}
+
+// ####################### SUMS, DIFFERENCES, SECTIONS ######################
+
+// PartialSums returns b(n) = a(0) + ... + a(n)
+func PartialSums(a []*bint) []*bint {
+	b := zeros(len(a))
+	sum := new(bint)
+	for i, v := range a {
+		b[i].Set(sum.Add(sum, v))
+	}
+	return b
+}
+
+// Differences returns b(n) = a(n+1) - a(n), which is one term shorter
+func Differences(a []*bint) []*bint {
+	if len(a) == 0 {
+		return a
+	}
+	b := zeros(len(a) - 1)
+	for i := range b {
+		b[i].Sub(a[i+1], a[i])
+	}
+	return b
+}
+
+// Section returns b(n) = a(kn + r); Section(a, 2, 0) and Section(a, 2, 1)
+// are the bisections of a
+func Section(a []*bint, k, r int) []*bint {
+	b := make([]*bint, 0)
+	for i := r; i < len(a); i += k {
+		b = append(b, new(bint).Set(a[i]))
+	}
+	return b
+}
@@ -0,0 +1,101 @@
+package transform
+
+import (
+	"math/big"
+	"testing"
+)
+
+// returns the given terms as big.Ints
+func ints(terms ...int64) []*bint {
+	a := make([]*bint, len(terms))
+	for i, t := range terms {
+		a[i] = big.NewInt(t)
+	}
+	return a
+}
+
+// returns n ones
+func ones(n int) []*bint {
+	a := make([]*bint, n)
+	for i := range a {
+		a[i] = big.NewInt(1)
+	}
+	return a
+}
+
+func equal(a, b []*bint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Cmp(b[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTransforms(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []*bint
+		want []*bint
+	}{
+		{"binomial", Binomial(ones(8)), ints(1, 2, 4, 8, 16, 32, 64, 128)},
+		{"ibinomial", InverseBinomial(ints(1, 2, 4, 8, 16)), ints(1, 1, 1, 1, 1)},
+		{"stirling", Stirling(ones(8)), ints(1, 1, 2, 5, 15, 52, 203, 877)},
+		{"istirling", InverseStirling(ints(1, 1, 2, 5, 15, 52, 203, 877)), ones(8)},
+		{"boustrophedon", Boustrophedon(ints(1, 0, 0, 0, 0, 0, 0, 0)), ints(1, 1, 1, 2, 5, 16, 61, 272)},
+		{"boustrophedon of 1s", Boustrophedon(ones(7)), ints(1, 2, 4, 9, 24, 77, 294)},
+		{"euler", Euler(ones(8)), ints(1, 2, 3, 5, 7, 11, 15, 22)},
+		{"ieuler", InverseEuler(ints(1, 2, 3, 5, 7, 11, 15, 22)), ones(8)},
+		{"mobius", Mobius(ones(6)), ints(1, 0, 0, 0, 0, 0)},
+		{"imobius", InverseMobius(ones(8)), ints(1, 2, 2, 3, 2, 4, 2, 4)},
+		{"conv", Convolution(ones(5), ints(1, 2, 3, 4, 5, 6)), ints(1, 3, 6, 10, 15)},
+		{"dirichlet", DirichletConvolution(ones(8), ones(8)), ints(1, 2, 2, 3, 2, 4, 2, 4)},
+		{"psum", PartialSums(ints(1, 2, 3, 4)), ints(1, 3, 6, 10)},
+		{"diff", Differences(ints(1, 3, 6, 10)), ints(2, 3, 4)},
+		{"section", Section(ints(0, 1, 2, 3, 4, 5, 6), 3, 1), ints(1, 4)},
+	}
+	for _, tt := range tests {
+		if !equal(tt.got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+// TestInverses checks that each inverse undoes its transform on a sequence
+// with no special structure
+func TestInverses(t *testing.T) {
+	a := ints(3, -1, 4, 1, -5, 9, 2, 6, 5, 3)
+	pairs := map[string][2]Func{
+		"binomial": {Binomial, InverseBinomial},
+		"stirling": {Stirling, InverseStirling},
+		"euler":    {Euler, InverseEuler},
+		"mobius":   {Mobius, InverseMobius},
+	}
+	for name, p := range pairs {
+		if got := p[1](p[0](a)); !equal(got, a) {
+			t.Errorf("%s: inverse(transform(a)) = %v, want %v", name, got, a)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"binomial", "conv", "section:2:0"} {
+		if _, err := Lookup(name); err != nil {
+			t.Errorf("Lookup(%q): %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "nope", "section:0:1", "section:2", "section:a:b"} {
+		if _, err := Lookup(name); err == nil {
+			t.Errorf("Lookup(%q) succeeded, want an error", name)
+		}
+	}
+	for _, name := range Names() {
+		want := name == "euler" || name == "ieuler" || name == "mobius" || name == "imobius" || name == "dirichlet"
+		if FromOne(name) != want {
+			t.Errorf("FromOne(%q) = %v, want %v", name, FromOne(name), want)
+		}
+	}
+}
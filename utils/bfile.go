@@ -0,0 +1,78 @@
+// ============================================================================
+// = bfile.go
+// = 	Description		Reads and writes OEIS b-files
+// = 	Note			See https://oeis.org/SubmittingBFiles.html
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ############################### B-FILES ##################################
+// ### a b-file lists one term per line as "n a(n)", starting at the offset.
+// ### lines starting with # are comments, and blank lines are ignored.
+
+// writes a as a b-file, where the first term is a(offset). There is no
+// header and no color, so the output can be submitted to the OEIS as is.
+func WriteBFile(w io.Writer, a []*bint, offset int64) error {
+	bw := bufio.NewWriter(w)
+	p := &bfilePrinter{w: bw}
+	for i, v := range a {
+		if err := p.Term(offset+int64(i), v); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// reads a b-file, returning its terms and its offset (the first n).
+// The indices must be consecutive.
+func ReadBFile(r io.Reader) ([]*bint, int64, error) {
+	a := make([]*bint, 0)
+	offset := int64(0)
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024) // terms can be very long
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			return nil, 0, bfileError(line, "expected \"n a(n)\", got "+strconv.Quote(text))
+		}
+		n, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, 0, bfileError(line, "invalid index "+strconv.Quote(fields[0]))
+		}
+		v, ok := zero().SetString(fields[1], 10)
+		if !ok {
+			return nil, 0, bfileError(line, "invalid term "+strconv.Quote(fields[1]))
+		}
+
+		if len(a) == 0 {
+			offset = n
+		} else if n != offset+int64(len(a)) {
+			return nil, 0, bfileError(line, "expected index "+strconv.FormatInt(offset+int64(len(a)), 10)+", got "+fields[0])
+		}
+		a = append(a, v)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, 0, err
+	}
+	return a, offset, nil
+}
+
+// the error for a malformed line of a b-file
+func bfileError(line int, msg string) error {
+	return errors.New("b-file line " + strconv.Itoa(line) + ": " + msg)
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBFileRoundTrip(t *testing.T) {
+	a := ToBigSlice([]int64{0, 1, 1, 2, 3, 5, 8})
+	a = append(a, pow(inew(2), inew(100)))
+
+	var buf bytes.Buffer
+	if err := WriteBFile(&buf, a, 0); err != nil {
+		t.Fatal(err)
+	}
+	want := "0 0\n1 1\n2 1\n3 2\n4 3\n5 5\n6 8\n7 1267650600228229401496703205376\n"
+	if buf.String() != want {
+		t.Errorf("wrote\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	got, offset, err := ReadBFile(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset != 0 || len(got) != len(a) {
+		t.Fatalf("read %d terms at offset %d, want %d at offset 0", len(got), offset, len(a))
+	}
+	for i := range a {
+		if got[i].Cmp(a[i]) != 0 {
+			t.Errorf("a(%d) = %v, want %v", i, got[i], a[i])
+		}
+	}
+}
+
+func TestReadBFile(t *testing.T) {
+	in := "# A000040\n# comment\n\n1 2\n2 3\n  3 5  \n"
+	a, offset, err := ReadBFile(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset != 1 || len(a) != 3 || a[2].Int64() != 5 {
+		t.Errorf("got %v at offset %d", a, offset)
+	}
+
+	for _, bad := range []string{"1 2\n3 5\n", "1\n", "x 2\n", "1 two\n"} {
+		if _, _, err := ReadBFile(strings.NewReader(bad)); err == nil {
+			t.Errorf("ReadBFile(%q) succeeded, want an error", bad)
+		}
+	}
+}
@@ -7,7 +7,6 @@
 package utils
 
 import (
-	"errors"
 	"math"
 )
 
@@ -52,18 +51,74 @@ func SumBig(a []*bint) *bint {
 	return sum
 }
 
+// computes n! with a single product over [1, n]; 0! = 1
+func Factorial(n int64) *bint {
+	return mulRange(1, n)
+}
+
+// computes the nth Fibonacci number, F(0) = 0, F(1) = 1, in O(log n) steps
+// F(-n) = (-1)^(n+1)*F(n) for negative n
+func Fibonacci(n int64) *bint {
+	if n < 0 {
+		f := Fibonacci(-n)
+		if n%2 == 0 {
+			f.Neg(f)
+		}
+		return f
+	}
+	f, _ := fibPair(n)
+	return f
+}
+
+// computes the nth Lucas number, L(0) = 2, L(1) = 1, in O(log n) steps
+// L(-n) = (-1)^n*L(n) for negative n
+func Lucas(n int64) *bint {
+	if n < 0 {
+		l := Lucas(-n)
+		if n%2 != 0 {
+			l.Neg(l)
+		}
+		return l
+	}
+	// L(n) = 2*F(n+1) - F(n)
+	f, f1 := fibPair(n)
+	return sub(mul(inew(2), f1), f)
+}
+
+// returns F(n) and F(n+1) by fast doubling, for n >= 0:
+// F(2k) = F(k)*(2F(k+1) - F(k)) and F(2k+1) = F(k)^2 + F(k+1)^2
+func fibPair(n int64) (*bint, *bint) {
+	if n == 0 {
+		return zero(), inew(1)
+	}
+	a, b := fibPair(n / 2)
+	c := mul(a, sub(mul(inew(2), b), a))
+	d := add(mul(a, a), mul(b, b))
+	if n%2 == 0 {
+		return c, d
+	}
+	return d, add(c, d)
+}
+
 // this computes Sigma_e(n), which computes the sum of the divisors of n
 // where the divisors are raised to the power of e
 func Sigma(n, e int64) *bint {
-	divisors := Factors(n)
-	bigdiv := iSlice(int64(len(divisors)))
-
-	// raise each divisor to the power of e
-	for i := 0; i < len(divisors); i++ {
-		bigdiv[i] = pow(inew(divisors[i]), inew(e))
+	if n < 1 {
+		return zero()
 	}
 
-	return SumBig(bigdiv)
+	// sigma_e is multiplicative: sigma_e(p^k) = 1 + p^e + p^2e + ... + p^ke
+	prod := inew(1)
+	for _, f := range Factorize(n) {
+		pe := pow(inew(f.P), inew(e))
+		term, sum := inew(1), inew(1)
+		for k := int64(1); k <= f.E; k++ {
+			term = mul(term, pe)
+			sum = add(sum, term)
+		}
+		prod = mul(prod, sum)
+	}
+	return prod
 }
 
 // ================= PROBABILITY & COMBINATIONS =================
@@ -71,12 +126,11 @@ func Sigma(n, e int64) *bint {
 // ### Examples: Binomial, permutations, combinations
 
 // computes the nCr(n, r) (binomial coefficient)
+// like nCr(), this returns 0 if n < 0, r < 0, or n < r
 func Binomial(n, r int64) int64 {
 	// C(n,r) = n!/((n-r)!r!), but this is inefficient
-	if n < 0 || r < 0 {
-		HandleError(errors.New("can't be negative"))
-	} else if n < r {
-		HandleError(errors.New("n cannot be less than r"))
+	if n < 0 || r < 0 || n < r {
+		return 0
 	}
 
 	// do the shortcut, where you can modify r based on n
@@ -111,22 +165,20 @@ func Permutation(n *bint) []*bint {
 // ##################### DIVISORS & FACTORS #########################
 // given a number num, it will compute Euler's Totient of the number
 func EulerTotient(num int64) int64 {
-	val := int64(0)
-	for i := int64(0); i < num; i++ {
-		if GCD(i, num) == 1 {
-			val++
-		}
+	if num < 1 {
+		return 0
 	}
-	return val
+	return Totient.Eval(num)
 }
 
 // computes Euler's Totient, but with arbitrary precision
 func EulerTotientBig(num *bint) *bint {
-	val := zero()
-	for i := zero(); lt(i, num); inc(i) {
-		if equals(GCD_big(i, num), inew(1)) {
-			val = add(val, inew(1))
-		}
+	if num.Sign() <= 0 {
+		return zero()
+	}
+	val := add(zero(), num)
+	for _, f := range FactorizeBig(num) {
+		val = mul(div(val, f.P), sub(f.P, inew(1)))
 	}
 	return val
 }
@@ -159,14 +211,10 @@ func GetDigits(n int64) int64 {
 
 // Calculate the number of factors of num
 func GetFactorCount(num int64) int64 {
-	// calculate factor count
-	count := int64(0)
-	for i := int64(1); i <= num; i++ {
-		if num%i == 0 {
-			count++
-		}
+	if num < 1 {
+		return 0
 	}
-	return count
+	return Tau.Eval(num)
 }
 
 // finds the first digit of the number
@@ -182,27 +230,16 @@ func GetFirstDigit(n int64) int64 {
 func PrimeFactorization(num int64) []int64 {
 	// initialize array
 	primefact := make([]int64, 0)
-
-	// the number of 2s
-	for num%2 == 0 {
-		primefact = append(primefact, 2)
-		num /= 2
+	if num < 1 {
+		return primefact // 0 and negatives have no prime factorization
 	}
 
-	// num is now odd. check 3s and beyond
-	for i := int64(3); i*i <= num; i += 2 {
-		// get factors until n is zero
-		for num%i == 0 {
-			primefact = append(primefact, i)
-			num /= i
+	// each prime, as many times as it divides num
+	for _, f := range Factorize(num) {
+		for k := int64(0); k < f.E; k++ {
+			primefact = append(primefact, f.P)
 		}
 	}
-
-	// what if num is prime?
-	if num > 2 {
-		primefact = append(primefact, num)
-	}
-
 	return primefact
 }
 
@@ -252,7 +289,7 @@ func PrimeFloor(arr []int64, n int64) int64 {
 // uses big.Rat b/c OEIS has a sequence of the numerators & of the denominators
 // plus, there's no nonsense about float precision
 func Bernoulli(n int64) *brat {
-	var f *brat
+	f := rzero()
 	a := rSlice(n + 1)
 	for m := range a {
 		a[m].SetFrac64(1, int64(m+1))
@@ -391,15 +428,21 @@ func Stirling1(n, k int64) *bint {
 	}
 }
 
-// Computes the stirling numbers of the second kind for n, k
+// Computes the stirling numbers of the second kind for n, k exactly:
+// S(n, k) = (1/k!) * Sum_{i=0..k} (-1)^(k-i) * C(k, i) * i^n
 func Stirling2(n, k int64) *bint {
-	nb := inew(n)
-	stir := fzero()
+	if n < 0 || k < 0 {
+		return zero()
+	}
+	nb, kb := inew(n), inew(k)
+	sum := zero()
 	for i := int64(0); i <= k; i++ {
 		ib := inew(i)
-		numer := mul(pow(inew(-1), inew(k-i)), pow(ib, nb))
-		denom := mul(fact(inew(k-i)), fact(ib))
-		stir = fadd(stir, fdiv(itof(numer), itof(denom)))
+		term := mul(nCr(kb, ib), pow(ib, nb))
+		if (k-i)%2 == 1 {
+			term.Neg(term)
+		}
+		sum.Add(sum, term)
 	}
-	return round(stir)
+	return sum.Quo(sum, Factorial(k))
 }
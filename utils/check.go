@@ -14,8 +14,9 @@ import (
 // ### this section checks if a number has a specific property
 
 // IsPrime returns true if num is prime. False otherwise.
+// The test is deterministic; see isPrime64.
 func IsPrime(num int64) bool {
-	return probablyPrime(inew(num), 20)
+	return num > 1 && isPrime64(uint64(num))
 }
 
 // IsBigPrime returns true if num is prime. False otherwise.
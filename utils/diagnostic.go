@@ -0,0 +1,148 @@
+// ============================================================================
+// = diagnostic.go
+// = 	Description		Structured diagnostics (warnings, notes) emitted by sequences
+// = 	Note			Library code never prints; the caller passes a Sink in the context
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// ############################ DIAGNOSTICS ############################
+// ### sequences report warnings as Diagnostics rather than printing them.
+// ### nothing is printed unless the caller passes a Sink with WithSink.
+
+// DiagnosticKind is the category of a diagnostic
+type DiagnosticKind int
+
+const (
+	LongCalculation DiagnosticKind = iota // the calculation will take a while
+	Accuracy                              // the terms may be inaccurate
+	BigInt                                // big.Int fields may cause accuracy issues
+	Note                                  // anything else worth telling the user
+)
+
+// String returns the name of the kind, as used in JSON output
+func (k DiagnosticKind) String() string {
+	switch k {
+	case LongCalculation:
+		return "long-calculation"
+	case Accuracy:
+		return "accuracy"
+	case BigInt:
+		return "big-int"
+	}
+	return "note"
+}
+
+// MarshalText lets the kind appear by name in JSON
+func (k DiagnosticKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Diagnostic is a single warning or note about a sequence
+type Diagnostic struct {
+	Kind      DiagnosticKind `json:"kind"`
+	Seq       string         `json:"seq"`                 // the sequence that emitted it
+	Threshold int64          `json:"threshold,omitempty"` // the seqlen or index it applies past, 0 if none
+	Message   string         `json:"message"`
+}
+
+// Sink receives every diagnostic emitted by the seq and utils packages
+type Sink func(d Diagnostic)
+
+// the context key of the sink
+type sinkKey struct{}
+
+// WithSink returns a copy of ctx that carries s, so that the diagnostics of
+// every call made with it go to s. A nil sink discards diagnostics.
+func WithSink(ctx context.Context, s Sink) context.Context {
+	return context.WithValue(ctx, sinkKey{}, s)
+}
+
+// Emit sends d to the sink carried by ctx, if any
+func Emit(ctx context.Context, d Diagnostic) {
+	if s, _ := ctx.Value(sinkKey{}).(Sink); s != nil {
+		s(d)
+	}
+}
+
+// Collector is a Sink that keeps every diagnostic it receives
+type Collector struct {
+	mu    sync.Mutex
+	diags []Diagnostic
+}
+
+// Sink returns a Sink that appends to the collector
+func (c *Collector) Sink() Sink {
+	return func(d Diagnostic) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.diags = append(c.diags, d)
+	}
+}
+
+// Diagnostics returns a copy of everything collected so far
+func (c *Collector) Diagnostics() []Diagnostic {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Diagnostic(nil), c.diags...)
+}
+
+// ############################ WARNINGS ###########################
+// ### these build the diagnostics; whoever computes the sequence emits them.
+
+// used to issue a warning about sequence lengths that will take a long time to compute
+// a "long time" is typically more than 5 seconds (which isn't that long, but users are impatient)
+func LongCalculationWarningWithLength(seqname string, seqlen int64) Diagnostic {
+	slen := strconv.FormatInt(seqlen, 10)
+	return Diagnostic{
+		Kind:      LongCalculation,
+		Seq:       seqname,
+		Threshold: seqlen,
+		Message:   "Sequence " + seqname + " with a seqlen > " + slen + " will take time to compute!",
+	}
+}
+
+func LongCalculationWarning(seqname string) Diagnostic {
+	return Diagnostic{
+		Kind:    LongCalculation,
+		Seq:     seqname,
+		Message: "Sequence " + seqname + " will take a non-trivial time to compute!",
+	}
+}
+
+// used to issue a warning about a sequence that uses big.Int fields instead of int64
+func BigIntWarning(seqid string, seqlen int64) Diagnostic {
+	slen := strconv.FormatInt(seqlen, 10)
+	return Diagnostic{
+		Kind:      BigInt,
+		Seq:       seqid,
+		Threshold: seqlen,
+		Message:   "Sequence " + seqid + " has big.Int fields instead of int64. This *MAY* lead to accuracy issues, especially for seqlen > " + slen + ".",
+	}
+}
+
+// used to issue a warning about the accuracy of a sequence using big.Float fields
+func AccuracyWarning(seqid string) Diagnostic {
+	return Diagnostic{
+		Kind:    Accuracy,
+		Seq:     seqid,
+		Message: "Calculation for sequence " + seqid + " may be inaccurate. This is usually due to limited accuracy of float values which are then rounded.",
+	}
+}
+
+// used to issue a warning that a sequence is inaccurate past a given index
+func AccuracyWarningWithLength(seqid string, n int64, msg string) Diagnostic {
+	return Diagnostic{Kind: Accuracy, Seq: seqid, Threshold: n, Message: msg}
+}
+
+// used to tell the user something about a sequence that is not a warning
+func NoteDiagnostic(seqid string, msg string) Diagnostic {
+	return Diagnostic{Kind: Note, Seq: seqid, Message: msg}
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"context"
+	"testing"
+)
+
+// TestWithSink checks that diagnostics go to the sink carried by the
+// context, and are dropped without one
+func TestWithSink(t *testing.T) {
+	var c Collector
+	ctx := WithSink(context.Background(), c.Sink())
+	Emit(ctx, LongCalculationWarning("A000008"))
+	Emit(ctx, NoteDiagnostic("A000044", "m"))
+
+	// a context derived from ctx keeps its sink
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+	Emit(child, AccuracyWarning("A000329"))
+
+	// neither of these reaches c
+	Emit(context.Background(), AccuracyWarning("A000111"))
+	Emit(WithSink(ctx, nil), AccuracyWarning("A000207"))
+
+	got := c.Diagnostics()
+	want := []struct {
+		kind DiagnosticKind
+		seq  string
+	}{{LongCalculation, "A000008"}, {Note, "A000044"}, {Accuracy, "A000329"}}
+	if len(got) != len(want) {
+		t.Fatalf("collected %v, want %d diagnostics", got, len(want))
+	}
+	for i, w := range want {
+		if got[i].Kind != w.kind || got[i].Seq != w.seq {
+			t.Errorf("diagnostic %d is %s for %s, want %s for %s", i, got[i].Kind, got[i].Seq, w.kind, w.seq)
+		}
+	}
+
+	// the collector hands out copies
+	got[0].Seq = "changed"
+	if c.Diagnostics()[0].Seq != "A000008" {
+		t.Error("changing the result of Diagnostics changed the collector")
+	}
+}
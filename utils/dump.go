@@ -0,0 +1,183 @@
+// ============================================================================
+// = dump.go
+// = 	Description		Reads the OEIS stripped and names dump files
+// = 	Note			Download them from https://oeis.org/stripped.gz and
+// = 					https://oeis.org/names.gz; they may be gzipped or not
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ############################## OEIS DUMPS ################################
+// ### stripped.gz has one line per sequence: "A000045 ,0,1,1,2,3,5,8,...,"
+// ### and names.gz one line per sequence: "A000045 Fibonacci numbers: ...".
+// ### lines starting with # are comments. The dumps don't include offsets.
+
+// Dump holds the leading terms and names of every sequence in the OEIS,
+// indexed in memory by A-number
+type Dump struct {
+	ids   []string          // every A-number with terms, in ascending order
+	terms map[string]string // the terms as in stripped.gz, e.g. ",0,1,1,2,"
+	names map[string]string
+}
+
+// DumpMatch is a sequence of a Dump whose terms contain the queried terms
+type DumpMatch struct {
+	ID    string
+	Index int // the position of the first matching term, 0 for the first term
+}
+
+// OpenDump reads the stripped and names files at the given paths, either of
+// which may be "" to skip it. The files may be gzipped.
+func OpenDump(stripped, names string) (*Dump, error) {
+	d := NewDump()
+	if stripped != "" {
+		if err := readDumpFile(stripped, d.ReadStripped); err != nil {
+			return nil, err
+		}
+	}
+	if names != "" {
+		if err := readDumpFile(names, d.ReadNames); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// NewDump creates an empty Dump, to be filled with ReadStripped and ReadNames
+func NewDump() *Dump {
+	return &Dump{ids: []string{}, terms: map[string]string{}, names: map[string]string{}}
+}
+
+// ReadStripped adds the terms in r, in the format of stripped.gz, to d.
+// r may be gzipped.
+func (d *Dump) ReadStripped(r io.Reader) error {
+	err := readDumpLines(r, func(id, rest string) error {
+		if !strings.HasPrefix(rest, ",") || !strings.HasSuffix(rest, ",") {
+			return errors.New("expected terms between commas, got " + strconv.Quote(rest))
+		}
+		if _, exists := d.terms[id]; !exists {
+			d.ids = append(d.ids, id)
+		}
+		d.terms[id] = rest
+		return nil
+	})
+	sort.Strings(d.ids)
+	return err
+}
+
+// ReadNames adds the names in r, in the format of names.gz, to d.
+// r may be gzipped.
+func (d *Dump) ReadNames(r io.Reader) error {
+	return readDumpLines(r, func(id, rest string) error {
+		d.names[id] = rest
+		return nil
+	})
+}
+
+// IDs returns every A-number with terms in d, in ascending order
+func (d *Dump) IDs() []string {
+	return d.ids
+}
+
+// Name returns the name of the sequence id, or "" if d has none
+func (d *Dump) Name(id string) string {
+	return d.names[id]
+}
+
+// Terms returns the leading terms of the sequence id
+func (d *Dump) Terms(id string) ([]*bint, bool) {
+	data, ok := d.terms[id]
+	if !ok {
+		return nil, false
+	}
+	fields := strings.Split(strings.Trim(data, ","), ",")
+	a := make([]*bint, 0, len(fields))
+	for _, f := range fields {
+		if v, ok := zero().SetString(f, 10); ok {
+			a = append(a, v)
+		}
+	}
+	return a, true
+}
+
+// Find returns the sequences whose terms contain query as a contiguous run,
+// in ascending order of A-number
+func (d *Dump) Find(query []*bint) []DumpMatch {
+	found := make([]DumpMatch, 0)
+	if len(query) == 0 {
+		return found
+	}
+
+	// search the text of the terms, like the OEIS does: ",1,1,2,5,"
+	var b strings.Builder
+	for _, v := range query {
+		b.WriteString("," + v.String())
+	}
+	needle := b.String() + ","
+
+	for _, id := range d.ids {
+		data := d.terms[id]
+		if i := strings.Index(data, needle); i >= 0 {
+			// every term is preceded by a comma
+			found = append(found, DumpMatch{ID: id, Index: strings.Count(data[:i], ",")})
+		}
+	}
+	return found
+}
+
+// opens the file at path and hands it to read
+func readDumpFile(path string, read func(r io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := read(f); err != nil {
+		return errors.New(path + ": " + err.Error())
+	}
+	return nil
+}
+
+// calls line with the A-number and the rest of every line of r that isn't a
+// comment, decompressing r first if it is gzipped
+func readDumpLines(r io.Reader, line func(id, rest string) error) error {
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		br = bufio.NewReader(zr)
+	}
+
+	sc := bufio.NewScanner(br)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for n := 1; sc.Scan(); n++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		id, rest, _ := strings.Cut(text, " ")
+		if len(id) != 7 || id[0] != 'A' {
+			return errors.New("line " + strconv.Itoa(n) + ": expected an A-number, got " + strconv.Quote(id))
+		}
+		if err := line(id, strings.TrimSpace(rest)); err != nil {
+			return errors.New("line " + strconv.Itoa(n) + ": " + err.Error())
+		}
+	}
+	return sc.Err()
+}
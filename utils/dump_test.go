@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const stripped = `# OEIS Sequence Data (http://oeis.org/stripped.gz)
+# Last Modified: October 17 2026
+A000045 ,0,1,1,2,3,5,8,13,21,34,
+A000108 ,1,1,2,5,14,42,132,429,
+A000179 ,1,-1,0,1,2,13,80,579,
+A088218 ,1,1,2,5,14,42,132,429,
+A111111 ,2,3,5,8,13,
+`
+
+const names = `# OEIS Sequence Names (http://oeis.org/names.gz)
+A000045 Fibonacci numbers: F(n) = F(n-1) + F(n-2) with F(0) = 0 and F(1) = 1.
+A000108 Catalan numbers: C(n) = binomial(2n,n)/(n+1) = (2n)!/(n!(n+1)!).
+`
+
+func TestDumpFind(t *testing.T) {
+	// the stripped file is gzipped, like the download, and names is not
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write([]byte(stripped))
+	zw.Close()
+
+	d := NewDump()
+	if err := d.ReadStripped(&gz); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.ReadNames(strings.NewReader(names)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query []int64
+		want  []DumpMatch
+	}{
+		{[]int64{1, 1, 2, 5, 14, 42}, []DumpMatch{{"A000108", 0}, {"A088218", 0}}},
+		{[]int64{3, 5, 8}, []DumpMatch{{"A000045", 4}, {"A111111", 1}}},
+		{[]int64{-1, 0, 1}, []DumpMatch{{"A000179", 1}}},
+		{[]int64{4, 2}, []DumpMatch{}},
+		{[]int64{1, 3}, []DumpMatch{}}, // 13 and 21 contain the digits, not the terms
+	}
+	for _, tt := range tests {
+		if got := d.Find(ToBigSlice(tt.query)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Find(%v) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	if got := d.Name("A000108"); !strings.HasPrefix(got, "Catalan numbers") {
+		t.Errorf("Name(A000108) = %q", got)
+	}
+	if a, ok := d.Terms("A000179"); !ok || len(a) != 8 || a[1].Int64() != -1 {
+		t.Errorf("Terms(A000179) = %v, %v", a, ok)
+	}
+	if !reflect.DeepEqual(d.IDs(), []string{"A000045", "A000108", "A000179", "A088218", "A111111"}) {
+		t.Errorf("IDs() = %v", d.IDs())
+	}
+}
+
+func TestReadStrippedErrors(t *testing.T) {
+	for _, bad := range []string{"A000045 0,1,1,2,\n", "45 ,0,1,1,\n", "A000045\n"} {
+		if err := NewDump().ReadStripped(strings.NewReader(bad)); err == nil {
+			t.Errorf("ReadStripped(%q) succeeded, want an error", bad)
+		}
+	}
+}
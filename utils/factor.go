@@ -0,0 +1,375 @@
+// ============================================================================
+// = factor.go
+// = 	Description		Factors integers into primes, and lists their divisors
+// = 	Note			Trial division, then Pollard's rho with Brent's cycle
+// = 					detection. int64s are certain; big.Ints use ProbablyPrime
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// ############################ FACTORIZATION ###############################
+// ### small factors are divided out by trial division, and what is left is
+// ### split with Pollard's rho until every part is prime. int64s are tested
+// ### with a Miller-Rabin test whose bases make it deterministic below 2^64.
+
+// trial division handles every prime below this
+const trialLimit = 1000
+
+// the odd primes below trialLimit
+var trialPrimes = PrimesUpTo(trialLimit)[1:]
+
+// PrimePower is a prime factor P^E of a number
+type PrimePower struct {
+	P int64
+	E int64
+}
+
+// BigPrimePower is a prime factor P^E of a big.Int
+type BigPrimePower struct {
+	P *bint
+	E int64
+}
+
+// Factorize returns the prime factorization of |n|, in increasing order of
+// the primes. 0 and ±1 have none.
+func Factorize(n int64) []PrimePower {
+	fs := make([]PrimePower, 0)
+
+	// |n| as a uint64, which holds |math.MinInt64| = 2^63 where -n overflows
+	m := uint64(n)
+	if n < 0 {
+		m = -m
+	}
+	if m < 2 {
+		return fs
+	}
+
+	// divide out the small primes
+	if k := bits.TrailingZeros64(m); k > 0 {
+		fs = append(fs, PrimePower{2, int64(k)})
+		m >>= k
+	}
+	for _, p := range trialPrimes {
+		if uint64(p*p) > m {
+			break
+		}
+		if m%uint64(p) == 0 {
+			e := int64(0)
+			for ; m%uint64(p) == 0; m /= uint64(p) {
+				e++
+			}
+			fs = append(fs, PrimePower{p, e})
+		}
+	}
+
+	// split what is left, whose prime factors are all >= trialLimit
+	if m > 1 {
+		large := make(map[uint64]int64)
+		splitPrimes(m, large)
+		for p, e := range large {
+			fs = append(fs, PrimePower{int64(p), e})
+		}
+		sort.Slice(fs, func(i, j int) bool { return fs[i].P < fs[j].P })
+	}
+	return fs
+}
+
+// adds the prime factors of m > 1 to fs, which counts their multiplicities
+func splitPrimes(m uint64, fs map[uint64]int64) {
+	if isPrime64(m) {
+		fs[m]++
+		return
+	}
+	d := pollardBrent(m)
+	splitPrimes(d, fs)
+	splitPrimes(m/d, fs)
+}
+
+// returns a nontrivial divisor of the odd composite m, with Pollard's rho
+// and Brent's cycle detection: the gcds are taken of a product of many
+// differences at once, and backtracked if that product skipped past one
+func pollardBrent(m uint64) uint64 {
+	if r := isqrt64(m); r*r == m {
+		return r // rho can't split the square of a prime
+	}
+	const batch = 128
+	for c := uint64(1); ; c++ {
+		f := func(x uint64) uint64 { return (mulmod(x, x, m) + c) % m }
+		y, ys, x := uint64(2), uint64(0), uint64(0)
+		g, q := uint64(1), uint64(1)
+		for r := uint64(1); g == 1; r *= 2 {
+			x = y
+			for i := uint64(0); i < r; i++ {
+				y = f(y)
+			}
+			for k := uint64(0); k < r && g == 1; k += batch {
+				ys = y
+				for i := uint64(0); i < batch && i < r-k; i++ {
+					y = f(y)
+					q = mulmod(q, absDiff(x, y), m)
+				}
+				g = gcd64(q, m)
+			}
+		}
+		if g == m {
+			// the batch went past the divisor; find it one step at a time
+			for g = 1; g == 1; {
+				ys = f(ys)
+				g = gcd64(absDiff(x, ys), m)
+			}
+		}
+		if g != m {
+			return g
+		}
+		// this c cycled without splitting m; try another polynomial
+	}
+}
+
+// reports whether n is prime, with the Miller-Rabin bases that are known
+// to make the test exact for every n < 2^64
+func isPrime64(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37} {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+
+	// n - 1 = d * 2^s with d odd
+	s := bits.TrailingZeros64(n - 1)
+	d := (n - 1) >> s
+	for _, a := range []uint64{2, 325, 9375, 28178, 450775, 9780504, 1795265022} {
+		a %= n
+		if a == 0 {
+			continue
+		}
+		x := powmod(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		composite := true
+		for i := 1; i < s; i++ {
+			x = mulmod(x, x, n)
+			if x == n-1 {
+				composite = false
+				break
+			}
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+// returns a*b mod m without overflowing
+func mulmod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, r := bits.Div64(hi%m, lo, m)
+	return r
+}
+
+// returns a^e mod m
+func powmod(a, e, m uint64) uint64 {
+	r := uint64(1)
+	for a %= m; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = mulmod(r, a, m)
+		}
+		a = mulmod(a, a, m)
+	}
+	return r
+}
+
+func gcd64(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// the integer square root of n
+func isqrt64(n uint64) uint64 {
+	r := uint64(isqrt(int64(n>>2)))*2 + 1 // n may not fit in an int64
+	for r*r > n {
+		r--
+	}
+	for (r+1)*(r+1) <= n {
+		r++
+	}
+	return r
+}
+
+// FactorizeBig returns the prime factorization of |n|, in increasing order
+// of the primes. Factors past int64 are tested with ProbablyPrime, so they
+// are prime with overwhelming probability.
+func FactorizeBig(n *bint) []BigPrimePower {
+	fs := make([]BigPrimePower, 0)
+	m := abs(n)
+	if m.IsInt64() {
+		for _, f := range Factorize(m.Int64()) {
+			fs = append(fs, BigPrimePower{inew(f.P), f.E})
+		}
+		return fs
+	}
+
+	// divide out the small primes
+	r := zero()
+	for _, p := range append([]int64{2}, trialPrimes...) {
+		bp := inew(p)
+		e := int64(0)
+		for {
+			q, rm := zero().QuoRem(m, bp, r)
+			if rm.Sign() != 0 {
+				break
+			}
+			m, e = q, e+1
+		}
+		if e > 0 {
+			fs = append(fs, BigPrimePower{bp, e})
+		}
+	}
+
+	// split the rest, once it is small enough to finish with int64s
+	large := make(map[string]BigPrimePower)
+	var split func(m *bint)
+	split = func(m *bint) {
+		if m.IsInt64() {
+			for _, f := range Factorize(m.Int64()) {
+				addBigFactor(large, inew(f.P), f.E)
+			}
+			return
+		}
+		if m.ProbablyPrime(20) {
+			addBigFactor(large, m, 1)
+			return
+		}
+		d := pollardBrentBig(m)
+		split(d)
+		split(quo(m, d))
+	}
+	if m.Cmp(inew(1)) > 0 {
+		split(m)
+	}
+	for _, f := range large {
+		fs = append(fs, f)
+	}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].P.Cmp(fs[j].P) < 0 })
+	return fs
+}
+
+// adds p^e to the factors in fs, which are keyed by p
+func addBigFactor(fs map[string]BigPrimePower, p *bint, e int64) {
+	key := p.String()
+	f, ok := fs[key]
+	if !ok {
+		f.P = p
+	}
+	f.E += e
+	fs[key] = f
+}
+
+// pollardBrent for a big.Int m > 2^63 that is odd and composite
+func pollardBrentBig(m *bint) *bint {
+	if r := sqrt(m); mul(r, r).Cmp(m) == 0 {
+		return r
+	}
+	const batch = 128
+	one := inew(1)
+	for c := int64(1); ; c++ {
+		bc := inew(c)
+		f := func(x *bint) *bint {
+			x = mul(x, x)
+			return x.Mod(x.Add(x, bc), m)
+		}
+		y, ys, x := inew(2), zero(), zero()
+		g, q := inew(1), inew(1)
+		for r := int64(1); g.Cmp(one) == 0; r *= 2 {
+			x = y
+			for i := int64(0); i < r; i++ {
+				y = f(y)
+			}
+			for k := int64(0); k < r && g.Cmp(one) == 0; k += batch {
+				ys = y
+				for i := int64(0); i < batch && i < r-k; i++ {
+					y = f(y)
+					q.Mod(q.Mul(q, abs(zero().Sub(x, y))), m)
+				}
+				g = zero().GCD(nil, nil, q, m)
+			}
+		}
+		if g.Cmp(m) == 0 {
+			for g = inew(1); g.Cmp(one) == 0; {
+				ys = f(ys)
+				g = zero().GCD(nil, nil, abs(zero().Sub(x, ys)), m)
+			}
+		}
+		if g.Cmp(m) != 0 {
+			return g
+		}
+	}
+}
+
+// ############################### DIVISORS #################################
+// ### the divisors are every product of the prime powers p^k, k <= e
+
+// Divisors returns every divisor of the number factored as fs, in
+// increasing order
+func Divisors(fs []PrimePower) []int64 {
+	divs := []int64{1}
+	for _, f := range fs {
+		n := len(divs)
+		pk := int64(1)
+		for k := int64(1); k <= f.E; k++ {
+			pk *= f.P
+			for _, d := range divs[:n] {
+				divs = append(divs, d*pk)
+			}
+		}
+	}
+	sort.Slice(divs, func(i, j int) bool { return divs[i] < divs[j] })
+	return divs
+}
+
+// DivisorsBig returns every divisor of the number factored as fs, in
+// increasing order
+func DivisorsBig(fs []BigPrimePower) []*bint {
+	divs := []*bint{inew(1)}
+	for _, f := range fs {
+		n := len(divs)
+		pk := inew(1)
+		for k := int64(1); k <= f.E; k++ {
+			pk = mul(pk, f.P)
+			for _, d := range divs[:n] {
+				divs = append(divs, mul(d, pk))
+			}
+		}
+	}
+	sort.Slice(divs, func(i, j int) bool { return divs[i].Cmp(divs[j]) < 0 })
+	return divs
+}
+
+// DivisorCount returns tau(n), the number of divisors of the number
+// factored as fs
+func DivisorCount(fs []PrimePower) int64 {
+	count := int64(1)
+	for _, f := range fs {
+		count *= f.E + 1
+	}
+	return count
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// multiplies the prime powers back together, checking that each is prime
+func checkFactorization(t *testing.T, n int64, fs []PrimePower) {
+	t.Helper()
+	prod := int64(1)
+	for i, f := range fs {
+		if !big.NewInt(f.P).ProbablyPrime(20) || f.E < 1 || (i > 0 && fs[i-1].P >= f.P) {
+			t.Fatalf("Factorize(%d) = %v, which has a bad factor %v", n, fs, f)
+		}
+		for k := int64(0); k < f.E; k++ {
+			prod *= f.P
+		}
+	}
+	if prod != n {
+		t.Fatalf("Factorize(%d) = %v, whose product is %d", n, fs, prod)
+	}
+}
+
+func TestFactorize(t *testing.T) {
+	for n := int64(1); n <= 10000; n++ {
+		checkFactorization(t, n, Factorize(n))
+	}
+	for _, n := range []int64{
+		1000000007 * 998244353,            // two large primes
+		3037000493 * 3037000493,           // the square of a prime near 2^31.5
+		4294967291 * 2147483647,           // two primes near 2^32 and 2^31
+		9223372036854775783,               // the largest int64 prime
+		3215031751,                        // a strong pseudoprime to bases 2, 3, 5 and 7
+		2 * 3 * 5 * 7 * 11 * 13 * 1000003, // small primes and a large one
+	} {
+		checkFactorization(t, n, Factorize(n))
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		n := rnd.Int63()
+		checkFactorization(t, n, Factorize(n))
+	}
+	if fs := Factorize(-12); !reflect.DeepEqual(fs, []PrimePower{{2, 2}, {3, 1}}) {
+		t.Errorf("Factorize(-12) = %v", fs)
+	}
+	if fs := Factorize(math.MinInt64); !reflect.DeepEqual(fs, []PrimePower{{2, 63}}) {
+		t.Errorf("Factorize(math.MinInt64) = %v", fs)
+	}
+	if fs := Factorize(math.MinInt64 + 1); !reflect.DeepEqual(fs, Factorize(math.MaxInt64)) {
+		t.Errorf("Factorize(math.MinInt64 + 1) = %v", fs)
+	}
+}
+
+// TestIsPrime compares the deterministic test with ProbablyPrime
+func TestIsPrime(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		n := rnd.Int63()
+		if i < 10000 {
+			n = int64(i)
+		}
+		if got, want := IsPrime(n), big.NewInt(n).ProbablyPrime(20); got != want {
+			t.Errorf("IsPrime(%d) = %v, want %v", n, got, want)
+		}
+	}
+	for _, n := range []int64{3215031751, 2152302898747, 3474749660383, 341550071728321, 3825123056546413051} {
+		if IsPrime(n) {
+			t.Errorf("IsPrime(%d) = true for a strong pseudoprime", n)
+		}
+	}
+}
+
+func TestFactorizeBig(t *testing.T) {
+	m89, _ := new(big.Int).SetString("618970019642690137449562111", 10) // 2^89 - 1
+	n := new(big.Int).Mul(big.NewInt(1000000007), m89)
+	n.Mul(n, big.NewInt(12))
+	want := []BigPrimePower{{big.NewInt(2), 2}, {big.NewInt(3), 1}, {big.NewInt(1000000007), 1}, {m89, 1}}
+	if got := FactorizeBig(n); !reflect.DeepEqual(got, want) {
+		t.Errorf("FactorizeBig(%v) = %v, want %v", n, got, want)
+	}
+
+	f6 := new(big.Int).Lsh(big.NewInt(1), 64)
+	f6.Add(f6, big.NewInt(1))
+	want = []BigPrimePower{{big.NewInt(274177), 1}, {big.NewInt(67280421310721), 1}}
+	if got := FactorizeBig(f6); !reflect.DeepEqual(got, want) {
+		t.Errorf("FactorizeBig(2^64 + 1) = %v, want %v", got, want)
+	}
+}
+
+// TestDivisors checks the divisor functions against counting by hand
+func TestDivisors(t *testing.T) {
+	for n := int64(1); n <= 2000; n++ {
+		want := make([]int64, 0)
+		sigma2, phi := int64(0), int64(0)
+		for d := int64(1); d <= n; d++ {
+			if n%d == 0 {
+				want = append(want, d)
+				sigma2 += d * d
+			}
+			if GCD(d, n) == 1 {
+				phi++
+			}
+		}
+		if got := Factors(n); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Factors(%d) = %v, want %v", n, got, want)
+		}
+		if got := GetFactorCount(n); got != int64(len(want)) {
+			t.Fatalf("GetFactorCount(%d) = %d, want %d", n, got, len(want))
+		}
+		if got := Sigma(n, 2); got.Int64() != sigma2 {
+			t.Fatalf("Sigma(%d, 2) = %v, want %d", n, got, sigma2)
+		}
+		if got := EulerTotient(n); got != phi {
+			t.Fatalf("EulerTotient(%d) = %d, want %d", n, got, phi)
+		}
+		if got := FactorsBig(big.NewInt(n), false); len(got) != len(want)-1 || (len(got) > 0 && got[len(got)-1].Int64() != want[len(want)-2]) {
+			t.Fatalf("FactorsBig(%d, false) = %v", n, got)
+		}
+	}
+}
@@ -7,7 +7,6 @@
 package utils
 
 import (
-	"fmt"
 	"math"
 )
 
@@ -15,23 +14,6 @@ import (
 // ### given a number, it will generate a sequence with some quality up to that
 // ### number. things like primes, evens, odds, etc.
 
-// given a sequence, it will return the bisection of that sequence
-func Bisection(seq []int64) []int64 {
-	a := make([]int64, 0)
-	for i := 0; i < len(seq); i += 2 {
-		a = append(a, seq[i])
-	}
-	return a
-}
-
-func BisectionBig(seq []*bint) []*bint {
-	a := iSlice(0)
-	for i := 0; i < len(seq); i += 2 {
-		a = append(a, seq[i])
-	}
-	return a
-}
-
 // counts the digits of a given number
 func countDigits(num int64) int64 {
 	a := num
@@ -61,26 +43,25 @@ func Digits(num int64) []int64 {
 
 // Computes ALL factors (divisors) of num
 func Factors(num int64) []int64 {
-	factors := make([]int64, 0)
-	for i := int64(1); i <= num; i++ {
-		if num%i == 0 {
-			factors = append(factors, i)
-		}
+	if num < 1 {
+		return make([]int64, 0)
 	}
-	return factors
+	return Divisors(Factorize(num))
 }
 
 // Computes ALL factors (divisors) of num.
 // Includes num in the result if includeNum is true.
 func FactorsBig(num *bint, includeNum bool) []*bint {
-	factors := iSlice(0)
-	for i := inew(1); lt(i, num); inc(i) {
-		if equals(mod(num, i), zero()) { // n % i == 0
-			factors = append(factors, add(zero(), i))
+	if num.Sign() <= 0 {
+		factors := iSlice(0)
+		if includeNum {
+			factors = append(factors, num)
 		}
+		return factors
 	}
-	if includeNum {
-		factors = append(factors, num)
+	factors := DivisorsBig(FactorizeBig(num))
+	if !includeNum {
+		factors = factors[:len(factors)-1]
 	}
 	return factors
 }
@@ -160,22 +141,7 @@ func Nacci(seqlen int64, k int64, firstIsZero bool) []*bint {
 	if k <= 0 {
 		return nil
 	}
-
-	a := iSlice(seqlen)
-	for n := int64(0); n < seqlen; n++ {
-		if firstIsZero && n == 0 {
-			a[n] = zero()
-		} else if n < k {
-			a[n] = inew(1)
-		} else {
-			sum := zero()
-			for i := k; i > 0; i-- {
-				sum = add(sum, a[n-i])
-			}
-			a[n] = sum
-		}
-	}
-	return a
+	return NacciRecurrence(k, firstIsZero).Terms(seqlen)
 }
 
 // generates a(n) = e^n
@@ -187,34 +153,6 @@ func Powers(seqlen int64, e *bint) []*bint {
 	return a
 }
 
-// generates the sequence of primes; count = num
-func Primes(seqlen int64) []int64 {
-	primes := make([]int64, 0)
-	num := int64(0)
-	for i := int64(0); i < seqlen; {
-		if IsPrime(num) {
-			primes = append(primes, num)
-			i++
-		}
-		num++
-	}
-	return primes
-}
-
-// performs Primes(), but with big.Int instead
-func PrimesBig(seqlen int64) []*bint {
-	primes := iSlice(0)
-	num := zero()
-	for i := int64(0); i < seqlen; {
-		if IsBigPrime(num) {
-			primes = append(primes, num)
-			i++
-		}
-		num = add(num, inew(1))
-	}
-	return primes
-}
-
 // generates a sequence calculating the # of positive integers <= 2^n
 // of the form px^2 + qy^2
 func Repr(seqlen, p, q, init int64) []*bint {
@@ -286,7 +224,6 @@ func Recontres(seqlen, k int64) []*bint {
 			numer := mulall(pow(inew(-1), inew(j-k)), nCr(jb, kb), fact(nb))
 			sum = fadd(sum, fdiv(itof(numer), itof(fact(jb))))
 		}
-		fmt.Println(n, sum)
 		a[n] = round(sum)
 	}
 	return a
@@ -0,0 +1,179 @@
+// ============================================================================
+// = guess.go
+// = 	Description		Guesses linear recurrences from the first terms of a
+// = 					sequence, with the Berlekamp-Massey algorithm
+// = 	Note			A guess is only as good as the terms that confirm it
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+import (
+	"math/big"
+)
+
+// ############################ BERLEKAMP-MASSEY ############################
+// ### Berlekamp-Massey finds the shortest linear recurrence that the terms
+// ### satisfy, in O(N^2) steps. A recurrence of order L is determined by 2L
+// ### terms, so only the terms past those are evidence for it. The terms are
+// ### first reduced modulo a large prime, where the algorithm is cheap: that
+// ### rules out most sequences before the exact run over the rationals.
+
+// the prime the terms are first reduced by, the largest below 2^63
+const guessPrime = 9223372036854775783
+
+// Guess is a linear recurrence that the first terms of a sequence satisfy,
+// a(n) = Coeffs[0] a(n-1) + ... + Coeffs[L-1] a(n-L) for n >= L, and its
+// g.f. Num(x)/Den(x), where a(0) is the first term
+type Guess struct {
+	Coeffs    []*brat
+	Init      []*bint // a(0), ..., a(L-1)
+	Num       []*brat
+	Den       []*brat
+	Confirmed int // how many terms past the first 2L agree with it
+}
+
+// Order returns L, the number of previous terms each term depends on
+func (g Guess) Order() int { return len(g.Coeffs) }
+
+// GuessRecurrence returns the shortest linear recurrence that the terms
+// satisfy, if they are more than twice its order, so that at least one term
+// confirms it
+func GuessRecurrence(a []*bint) (Guess, bool) {
+	if 2*berlekampMasseyMod(a) >= len(a) {
+		return Guess{}, false
+	}
+	c := berlekampMassey(a)
+	order := len(c) - 1
+	if 2*order >= len(a) {
+		return Guess{}, false
+	}
+
+	g := Guess{Confirmed: len(a) - 2*order, Den: c}
+	g.Coeffs = make([]*brat, order)
+	for i := range g.Coeffs {
+		g.Coeffs[i] = rneg(c[i+1])
+	}
+	g.Init = make([]*bint, order)
+	for i := range g.Init {
+		g.Init[i] = zero().Set(a[i])
+	}
+
+	// Num = Den * A(x), which vanishes from x^L on
+	g.Num = make([]*brat, order)
+	for n := range g.Num {
+		g.Num[n] = rzero()
+		for i := 0; i <= n; i++ {
+			g.Num[n].Add(g.Num[n], rmul(c[i], itor(a[n-i])))
+		}
+	}
+	for len(g.Num) > 0 && g.Num[len(g.Num)-1].Sign() == 0 {
+		g.Num = g.Num[:len(g.Num)-1]
+	}
+	return g, true
+}
+
+// Recurrence returns the guess as a LinearRecurrence, if its coefficients
+// are integers that fit in an int64
+func (g Guess) Recurrence() (LinearRecurrence, bool) {
+	r := LinearRecurrence{Coeffs: make([]int64, len(g.Coeffs)), Init: make([]int64, len(g.Init))}
+	for i, c := range g.Coeffs {
+		if !c.IsInt() || !c.Num().IsInt64() {
+			return LinearRecurrence{}, false
+		}
+		r.Coeffs[i] = c.Num().Int64()
+	}
+	for i, v := range g.Init {
+		if !v.IsInt64() {
+			return LinearRecurrence{}, false
+		}
+		r.Init[i] = v.Int64()
+	}
+	return r, true
+}
+
+// returns the connection polynomial C of the terms: C[0] = 1, and
+// Sum_i C[i] a(n-i) = 0 for every n >= L = len(C)-1. C[L] may be 0, when
+// the first terms don't follow the recurrence of the rest.
+func berlekampMassey(a []*bint) []*brat {
+	c, b := []*brat{rnew(1, 1)}, []*brat{rnew(1, 1)}
+	order, m := 0, 1
+	last := rnew(1, 1) // the discrepancy when b was last c
+	term := rzero()
+	for n := range a {
+		// the discrepancy of c at a(n)
+		d := itor(a[n])
+		for i := 1; i <= order; i++ {
+			if c[i].Sign() != 0 {
+				d.Add(d, term.Mul(c[i], itor(a[n-i])))
+			}
+		}
+		if d.Sign() == 0 {
+			m++
+			continue
+		}
+
+		// c -= d/last x^m b, which fixes a(n)
+		scale := rdiv(d, last)
+		next := make([]*brat, len(c))
+		copy(next, c)
+		for len(next) < len(b)+m {
+			next = append(next, rzero())
+		}
+		for i, bi := range b {
+			next[i+m] = rsub(next[i+m], rmul(scale, bi))
+		}
+		if 2*order <= n {
+			order, b, last, m = n+1-order, c, d, 1
+		} else {
+			m++
+		}
+		c = next
+	}
+	for len(c) < order+1 {
+		c = append(c, rzero())
+	}
+	return c[:order+1]
+}
+
+// returns the order of the shortest recurrence the terms satisfy modulo
+// guessPrime, which is almost always that over the rationals
+func berlekampMasseyMod(a []*bint) int {
+	const p = guessPrime
+	bp := new(big.Int).SetUint64(p)
+	s := make([]uint64, len(a))
+	r := zero()
+	for i, v := range a {
+		s[i] = r.Mod(v, bp).Uint64()
+	}
+
+	c, b := []uint64{1}, []uint64{1}
+	order, m := 0, 1
+	last := uint64(1)
+	for n := range s {
+		d := s[n]
+		for i := 1; i <= order; i++ {
+			d = (d + mulmod(c[i], s[n-i], p)) % p
+		}
+		if d == 0 {
+			m++
+			continue
+		}
+		scale := mulmod(d, powmod(last, p-2, p), p)
+		next := make([]uint64, len(c))
+		copy(next, c)
+		for len(next) < len(b)+m {
+			next = append(next, 0)
+		}
+		for i, bi := range b {
+			next[i+m] = (next[i+m] + p - mulmod(scale, bi, p)) % p
+		}
+		if 2*order <= n {
+			order, b, last, m = n+1-order, c, d, 1
+		} else {
+			m++
+		}
+		c = next
+	}
+	return order
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"testing"
+)
+
+// returns the rationals as strings, to compare them
+func ratStrings(r []*brat) []string {
+	s := make([]string, len(r))
+	for i, v := range r {
+		s[i] = v.RatString()
+	}
+	return s
+}
+
+func equalStrings(a []string, want ...string) bool {
+	if len(a) != len(want) {
+		return false
+	}
+	for i := range a {
+		if a[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGuessRecurrence(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         []*bint
+		coeffs    []string
+		num, den  []string
+		confirmed int
+	}{
+		{"fibonacci", NacciRecurrence(2, true).Terms(20), []string{"1", "1"}, []string{"0", "1"}, []string{"1", "-1", "-1"}, 16},
+		{"squares", LinearRecurrence{Coeffs: []int64{1}, Init: []int64{0},
+			Extra: []Inhomogeneous{{Poly: []int64{-1, 2}, Base: 1}}}.Terms(12),
+			[]string{"3", "-3", "1"}, []string{"0", "1", "1"}, []string{"1", "-3", "3", "-1"}, 6},
+		{"preamble", ToBigSlice([]int64{5, 1, 2, 4, 8, 16, 32, 64, 128}), []string{"2", "0"},
+			[]string{"5", "-9"}, []string{"1", "-2", "0"}, 5},
+		{"zeros", ToBigSlice([]int64{0, 0, 0}), []string{}, []string{}, []string{"1"}, 3},
+	}
+	for _, tt := range tests {
+		g, ok := GuessRecurrence(tt.a)
+		if !ok {
+			t.Errorf("%s: found no recurrence", tt.name)
+			continue
+		}
+		if !equalStrings(ratStrings(g.Coeffs), tt.coeffs...) || !equalStrings(ratStrings(g.Num), tt.num...) ||
+			!equalStrings(ratStrings(g.Den), tt.den...) || g.Confirmed != tt.confirmed {
+			t.Errorf("%s: got coeffs %v, g.f. %v / %v, %d confirmed", tt.name,
+				ratStrings(g.Coeffs), ratStrings(g.Num), ratStrings(g.Den), g.Confirmed)
+		}
+
+		// the guess reproduces the terms
+		r, ok := g.Recurrence()
+		if !ok {
+			t.Errorf("%s: the coefficients aren't integers", tt.name)
+			continue
+		}
+		for i, v := range r.Terms(int64(len(tt.a))) {
+			if v.Cmp(tt.a[i]) != 0 {
+				t.Errorf("%s: the recurrence gives a(%d) = %v, want %v", tt.name, i, v, tt.a[i])
+				break
+			}
+		}
+	}
+}
+
+func TestGuessNone(t *testing.T) {
+	for name, a := range map[string][]*bint{
+		"primes":     PrimesBig(40),
+		"factorials": {inew(1), inew(1), inew(2), inew(6), inew(24), inew(120), inew(720), inew(5040)},
+		"too short":  NacciRecurrence(5, false).Terms(10),
+	} {
+		if g, ok := GuessRecurrence(a); ok {
+			t.Errorf("%s: guessed a recurrence of order %d", name, g.Order())
+		}
+	}
+}
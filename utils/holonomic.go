@@ -0,0 +1,354 @@
+// ============================================================================
+// = holonomic.go
+// = 	Description		P-recursive (holonomic) recurrences, whose coefficients
+// = 					are polynomials in n, and a guesser for them
+// = 	Note			Guessing solves a linear system over the rationals, so
+// = 					it is only practical for small orders and degrees
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+// ######################## HOLONOMIC RECURRENCES ###########################
+// ### P0(n) a(n) = P1(n) a(n-1) + ... + Pr(n) a(n-r), where the Pi are
+// ### polynomials in n. Derangements, menage numbers and most sequences with
+// ### an algebraic or D-finite g.f. satisfy one.
+
+// Holonomic is the recurrence Polys[0](n) a(n) = Polys[1](n) a(n-1) + ... +
+// Polys[r](n) a(n-r) for n >= len(Init), starting from a(0), a(1), ... =
+// Init. Each polynomial is given by its coefficients, lowest degree first.
+type Holonomic struct {
+	Polys [][]*brat
+	Init  []*bint
+}
+
+// NewHolonomic returns the recurrence with the given integer polynomials and
+// initial terms
+func NewHolonomic(polys [][]int64, init []int64) Holonomic {
+	h := Holonomic{Polys: make([][]*brat, len(polys)), Init: ToBigSlice(init)}
+	for i, p := range polys {
+		h.Polys[i] = make([]*brat, len(p))
+		for j, c := range p {
+			h.Polys[i][j] = rnew(c, 1)
+		}
+	}
+	return h
+}
+
+// Order returns r, the number of previous terms each term depends on
+func (h Holonomic) Order() int { return len(h.Polys) - 1 }
+
+// returns p(n)
+func evalPoly(p []*brat, n int64) *brat {
+	val := rzero()
+	nr := rnew(n, 1)
+	for j := len(p) - 1; j >= 0; j-- {
+		val.Mul(val, nr)
+		val.Add(val, p[j])
+	}
+	return val
+}
+
+// Terms returns a(0), ..., a(seqlen-1). It fails if P0(n) is 0 for some n
+// past the initial terms, or if a term isn't an integer.
+func (h Holonomic) Terms(seqlen int64) ([]*bint, error) {
+	if len(h.Polys) == 0 || len(h.Init) < h.Order() {
+		return nil, errors.New("holonomic: a recurrence of order r needs r+1 polynomials and at least r initial terms")
+	}
+	a := iSlice(seqlen)
+	sum, term := rzero(), rzero()
+	for n := int64(0); n < seqlen; n++ {
+		if n < int64(len(h.Init)) {
+			a[n] = zero().Set(h.Init[n])
+			continue
+		}
+		lead := evalPoly(h.Polys[0], n)
+		if lead.Sign() == 0 {
+			return nil, errors.New("holonomic: the leading coefficient vanishes at n = " + strconv.FormatInt(n, 10))
+		}
+		sum.SetInt64(0)
+		for i := 1; i <= h.Order(); i++ {
+			if a[n-int64(i)].Sign() != 0 {
+				sum.Add(sum, term.Mul(evalPoly(h.Polys[i], n), itor(a[n-int64(i)])))
+			}
+		}
+		sum.Quo(sum, lead)
+		if !sum.IsInt() {
+			return nil, errors.New("holonomic: a(" + strconv.FormatInt(n, 10) + ") = " + sum.RatString() + " is not an integer")
+		}
+		a[n] = zero().Set(sum.Num())
+	}
+	return a, nil
+}
+
+// ############################### GUESSING #################################
+// ### the unknown coefficients c(i,j) of n^j in Pi appear linearly in
+// ### Sum_i Pi(n) a(n-i) = 0, so every n >= r gives one equation and the
+// ### recurrences are the null space of that system. The system is first
+// ### ranked modulo a prime, which is cheap: if it has full rank there, it
+// ### does over the rationals too, and there is no recurrence. Only the
+// ### equations past the one where the rank stops growing confirm a guess,
+// ### which rules out step functions: there P0 vanishes at each step, and
+// ### the equations between the steps are all alike. A recurrence may only
+// ### hold from some n past r, like the ménage numbers' from n = 4, so the
+// ### first few equations are skipped before trying more unknowns.
+
+// how many of the first equations a guess may skip
+const guessSkip = 3
+
+// HolonomicGuess is a holonomic recurrence that the first terms of a
+// sequence satisfy
+type HolonomicGuess struct {
+	Recurrence Holonomic
+	Degree     int // the largest degree of the polynomials
+	Confirmed  int // how many equations past the ones that determine it agree with it
+}
+
+// GuessHolonomic returns a holonomic recurrence of order at most maxOrder
+// and degree at most maxDegree that the terms satisfy, trying the ones with
+// the fewest coefficients first, and for each the ones that hold from the
+// smallest n. It is only returned if at least one equation past the ones
+// that determine it agrees with it.
+func GuessHolonomic(a []*bint, maxOrder, maxDegree int) (HolonomicGuess, bool) {
+	type shape struct{ order, degree int }
+	shapes := make([]shape, 0)
+	for r := 1; r <= maxOrder; r++ {
+		for d := 0; d <= maxDegree; d++ {
+			shapes = append(shapes, shape{r, d})
+		}
+	}
+	// by the number of unknowns, then by order
+	for i := 1; i < len(shapes); i++ {
+		for j := i; j > 0; j-- {
+			ui := (shapes[j].order + 1) * (shapes[j].degree + 1)
+			uj := (shapes[j-1].order + 1) * (shapes[j-1].degree + 1)
+			if ui > uj || (ui == uj && shapes[j].order >= shapes[j-1].order) {
+				break
+			}
+			shapes[j], shapes[j-1] = shapes[j-1], shapes[j]
+		}
+	}
+
+	for _, sh := range shapes {
+		unknowns := (sh.order + 1) * (sh.degree + 1)
+		for from := sh.order; from <= sh.order+guessSkip; from++ {
+			equations := len(a) - from
+			if equations < unknowns {
+				break // the null space would be forced, not found
+			}
+			rank, needed := rankMod(a, from, sh.order, sh.degree)
+			if rank == unknowns || needed == equations {
+				continue
+			}
+			for _, v := range nullSpace(holonomicSystem(a, from, sh.order, sh.degree), unknowns) {
+				h, ok := holonomicFrom(v, a, from, sh.order, sh.degree)
+				if ok {
+					return HolonomicGuess{Recurrence: h, Degree: sh.degree, Confirmed: equations - needed}, true
+				}
+			}
+		}
+	}
+	return HolonomicGuess{}, false
+}
+
+// the system Sum_{i,j} c(i,j) n^j a(n-i) = 0 for from <= n < len(a), where
+// from >= r, with the unknown c(i,j) in column i(d+1)+j
+func holonomicSystem(a []*bint, from, r, d int) [][]*brat {
+	rows := make([][]*brat, 0, len(a)-from)
+	for n := from; n < len(a); n++ {
+		row := make([]*brat, (r+1)*(d+1))
+		for i := 0; i <= r; i++ {
+			v := zero().Set(a[n-i])
+			for j := 0; j <= d; j++ {
+				row[i*(d+1)+j] = itor(v)
+				v.Mul(v, inew(int64(n)))
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// returns the rank of the holonomic system modulo guessPrime, and how many
+// of its first equations have that rank. The equations are reduced one at a
+// time against the ones before, each with a pivot column scaled to 1.
+func rankMod(a []*bint, from, r, d int) (rank, needed int) {
+	const p = guessPrime
+	bp := new(big.Int).SetUint64(p)
+	cols := (r + 1) * (d + 1)
+	basis := make([][]uint64, 0, cols)
+	pivots := make([]int, 0, cols)
+	red := zero()
+	for n := from; n < len(a) && len(basis) < cols; n++ {
+		row := make([]uint64, cols)
+		for i := 0; i <= r; i++ {
+			v := red.Mod(a[n-i], bp).Uint64()
+			for j := 0; j <= d; j++ {
+				row[i*(d+1)+j] = v
+				v = mulmod(v, uint64(n), p)
+			}
+		}
+		for k, b := range basis {
+			if f := row[pivots[k]]; f != 0 {
+				for c := range row {
+					row[c] = (row[c] + p - mulmod(f, b[c], p)) % p
+				}
+			}
+		}
+		col := 0
+		for col < cols && row[col] == 0 {
+			col++
+		}
+		if col == cols {
+			continue
+		}
+		inv := powmod(row[col], p-2, p)
+		for c := range row {
+			row[c] = mulmod(row[c], inv, p)
+		}
+		basis, pivots = append(basis, row), append(pivots, col)
+		needed = n - from + 1
+	}
+	return len(basis), needed
+}
+
+// returns a basis of the null space of the matrix, which has cols columns,
+// by reducing it to row echelon form
+func nullSpace(m [][]*brat, cols int) [][]*brat {
+	pivotCols := make([]int, 0)
+	rank := 0
+	term := rzero()
+	for col := 0; col < cols && rank < len(m); col++ {
+		pivot := -1
+		for i := rank; i < len(m); i++ {
+			if m[i][col].Sign() != 0 {
+				pivot = i
+				break
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+		m[rank], m[pivot] = m[pivot], m[rank]
+		inv := rinv(m[rank][col])
+		for k := col; k < cols; k++ {
+			m[rank][k].Mul(m[rank][k], inv)
+		}
+		for i := range m {
+			if i == rank || m[i][col].Sign() == 0 {
+				continue
+			}
+			f := rzero().Set(m[i][col])
+			for k := col; k < cols; k++ {
+				m[i][k].Sub(m[i][k], term.Mul(f, m[rank][k]))
+			}
+		}
+		pivotCols = append(pivotCols, col)
+		rank++
+	}
+
+	// one basis vector per free column
+	basis := make([][]*brat, 0)
+	isPivot := make(map[int]bool)
+	for _, c := range pivotCols {
+		isPivot[c] = true
+	}
+	for free := 0; free < cols; free++ {
+		if isPivot[free] {
+			continue
+		}
+		v := make([]*brat, cols)
+		for k := range v {
+			v[k] = rzero()
+		}
+		v[free].SetInt64(1)
+		for i, c := range pivotCols {
+			v[c].Neg(m[i][free])
+		}
+		basis = append(basis, v)
+	}
+	return basis
+}
+
+// turns a null vector into a recurrence with integer coefficients, in the
+// form P0(n) a(n) = P1(n) a(n-1) + ... for n >= from, or reports that P0
+// vanishes
+func holonomicFrom(v []*brat, a []*bint, from, r, d int) (Holonomic, bool) {
+	// clear the denominators, and divide out the common factor
+	l, g := inew(1), zero()
+	for _, c := range v {
+		l.Mul(l, quo(c.Denom(), gcd(l, c.Denom())))
+	}
+	ints := make([]*bint, len(v))
+	for k, c := range v {
+		ints[k] = quo(mul(c.Num(), l), c.Denom())
+		g = gcd(g, ints[k])
+	}
+	if g.Sign() == 0 {
+		return Holonomic{}, false
+	}
+
+	h := Holonomic{Polys: make([][]*brat, r+1)}
+	for i := 0; i <= r; i++ {
+		h.Polys[i] = make([]*brat, d+1)
+		for j := 0; j <= d; j++ {
+			c := itor(quo(ints[i*(d+1)+j], g))
+			if i > 0 {
+				c.Neg(c) // moved to the right-hand side
+			}
+			h.Polys[i][j] = c
+		}
+	}
+
+	// the leading polynomial has a positive leading coefficient
+	top := -1
+	for j := d; j >= 0 && top < 0; j-- {
+		if h.Polys[0][j].Sign() != 0 {
+			top = j
+		}
+	}
+	if top < 0 {
+		return Holonomic{}, false
+	}
+	if h.Polys[0][top].Sign() < 0 {
+		for _, p := range h.Polys {
+			for _, c := range p {
+				c.Neg(c)
+			}
+		}
+	}
+	for i, p := range h.Polys {
+		for len(p) > 1 && p[len(p)-1].Sign() == 0 {
+			p = p[:len(p)-1]
+		}
+		h.Polys[i] = p
+	}
+	// a last polynomial of 0 means the recurrence is really of lower order,
+	// found only because the first equation of that order was skipped
+	for len(h.Polys) > 1 {
+		last := h.Polys[len(h.Polys)-1]
+		if len(last) > 1 || last[0].Sign() != 0 {
+			break
+		}
+		h.Polys = h.Polys[:len(h.Polys)-1]
+	}
+
+	// the initial terms run up to from, and past the last n where P0 vanishes
+	count := from
+	for n := from; n < len(a); n++ {
+		if evalPoly(h.Polys[0], int64(n)).Sign() == 0 {
+			count = n + 1
+		}
+	}
+	h.Init = make([]*bint, count)
+	for i := range h.Init {
+		h.Init[i] = zero().Set(a[i])
+	}
+	return h, true
+}
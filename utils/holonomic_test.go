@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"testing"
+)
+
+// TestHolonomicTerms generates the derangements and the factorials
+func TestHolonomicTerms(t *testing.T) {
+	derangements := NewHolonomic([][]int64{{1}, {-1, 1}, {-1, 1}}, []int64{1, 0})
+	a, err := derangements.Terms(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalInts(a, 1, 0, 1, 2, 9, 44, 265, 1854, 14833, 133496) {
+		t.Errorf("derangements: %v", a)
+	}
+
+	// n a(n) = n^2 a(n-1) is n!, but only once n > 0
+	fact := NewHolonomic([][]int64{{0, 1}, {0, 0, 1}}, []int64{1})
+	if a, err = fact.Terms(8); err != nil || !equalInts(a, 1, 1, 2, 6, 24, 120, 720, 5040) {
+		t.Errorf("factorials: %v, %v", a, err)
+	}
+	if _, err := NewHolonomic([][]int64{{-3, 1}, {1}}, []int64{1}).Terms(5); err == nil {
+		t.Error("the leading coefficient vanishes at n = 3, but got no error")
+	}
+	if _, err := NewHolonomic([][]int64{{2}, {1}}, []int64{1}).Terms(3); err == nil {
+		t.Error("a(1) = 1/2, but got no error")
+	}
+}
+
+func TestGuessHolonomic(t *testing.T) {
+	// the Catalan numbers: (n+1) a(n) = (4n-2) a(n-1)
+	catalan := NewHolonomic([][]int64{{1, 1}, {-2, 4}}, []int64{1})
+	a, _ := catalan.Terms(30)
+	g, ok := GuessHolonomic(a, 2, 2)
+	if !ok {
+		t.Fatal("no recurrence found for the Catalan numbers")
+	}
+	if g.Recurrence.Order() != 1 || g.Degree != 1 || g.Confirmed != 26 {
+		t.Errorf("got order %d, degree %d, %d confirmed", g.Recurrence.Order(), g.Degree, g.Confirmed)
+	}
+	got, err := g.Recurrence.Terms(30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range a {
+		if got[i].Cmp(a[i]) != 0 {
+			t.Fatalf("the guess gives a(%d) = %v, want %v", i, got[i], a[i])
+		}
+	}
+
+	// (n-2) C(n,2) = n C(n-1,2) vanishes at n = 2, so the guess starts from
+	// three terms
+	a = make([]*bint, 30)
+	for n := range a {
+		a[n] = inew(int64(n * (n - 1) / 2))
+	}
+	if g, ok = GuessHolonomic(a, 2, 1); !ok || len(g.Recurrence.Init) != 3 {
+		t.Errorf("found %v, with %d initial terms", ok, len(g.Recurrence.Init))
+	}
+
+	// the ménage numbers' recurrence of degree 2 only holds from n = 4; at
+	// n = 3 it takes a factor (n-3) and degree 3
+	menage := NewHolonomic([][]int64{{-2, 1}, {3, -3, 1}, {3, -3, 1}, {-1, 1}}, []int64{1, -1, 0, 1})
+	a, _ = menage.Terms(40)
+	if g, ok = GuessHolonomic(a, 3, 3); !ok || g.Degree != 2 || !equalInts(g.Recurrence.Init, 1, -1, 0, 1) {
+		t.Errorf("found %v, with degree %d and initial terms %v", ok, g.Degree, g.Recurrence.Init)
+	}
+
+	if _, ok := GuessHolonomic(PrimesBig(40), 2, 2); ok {
+		t.Error("guessed a recurrence for the primes")
+	}
+}
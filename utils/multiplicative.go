@@ -0,0 +1,156 @@
+// ============================================================================
+// = multiplicative.go
+// = 	Description		Multiplicative and additive arithmetic functions
+// = 	Note			Defined on prime powers, then tabulated with a linear
+// = 					sieve or evaluated at a single n by factoring it
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+// ######################## ARITHMETIC FUNCTIONS ############################
+// ### a multiplicative function has f(mn) = f(m)f(n) whenever m and n are
+// ### coprime, and an additive one f(mn) = f(m) + f(n), so either is known
+// ### from its values on the prime powers p^e.
+
+// Multiplicative is a multiplicative function, given by f(p^e) for e >= 1
+type Multiplicative func(p, e int64) int64
+
+// Additive is an additive function, given by f(p^e) for e >= 1
+type Additive func(p, e int64) int64
+
+// the functions that come defined
+var (
+	Totient           Multiplicative = func(p, e int64) int64 { return ipow(p, e-1) * (p - 1) } // Euler's phi(n)
+	DedekindPsi       Multiplicative = func(p, e int64) int64 { return ipow(p, e-1) * (p + 1) } // psi(n) = n * Product_{p|n} (1 + 1/p)
+	Tau               Multiplicative = func(p, e int64) int64 { return e + 1 }                  // d(n), the number of divisors
+	Mobius            Multiplicative = mobius                                                   // mu(n)
+	Liouville         Multiplicative = liouville                                                // lambda(n) = (-1)^bigomega(n)
+	Radical           Multiplicative = func(p, e int64) int64 { return p }                      // rad(n), the product of the distinct primes
+	LargestOddDivisor Multiplicative = largestOddDivisor                                        // n without its factors of 2
+	Omega             Additive       = func(p, e int64) int64 { return 1 }                      // omega(n), the number of distinct primes
+	BigOmega          Additive       = func(p, e int64) int64 { return e }                      // Omega(n), the primes counted with multiplicity
+)
+
+// DivisorSigma returns sigma_k, the sum of the kth powers of the divisors
+func DivisorSigma(k int64) Multiplicative {
+	return func(p, e int64) int64 {
+		pk, term, sum := ipow(p, k), int64(1), int64(1)
+		for i := int64(1); i <= e; i++ {
+			term *= pk
+			sum += term
+		}
+		return sum
+	}
+}
+
+// JordanTotient returns J_k, the number of k-tuples of numbers <= n that
+// are coprime to n together with it; J_1 is Euler's totient
+func JordanTotient(k int64) Multiplicative {
+	return func(p, e int64) int64 {
+		return ipow(p, k*e) - ipow(p, k*(e-1))
+	}
+}
+
+func mobius(p, e int64) int64 {
+	if e == 1 {
+		return -1
+	}
+	return 0
+}
+
+func liouville(p, e int64) int64 {
+	if e%2 == 1 {
+		return -1
+	}
+	return 1
+}
+
+func largestOddDivisor(p, e int64) int64 {
+	if p == 2 {
+		return 1
+	}
+	return ipow(p, e)
+}
+
+// Eval returns f(n), for n >= 1, from the factorization of n
+func (f Multiplicative) Eval(n int64) int64 {
+	val := int64(1)
+	for _, pe := range Factorize(n) {
+		val *= f(pe.P, pe.E)
+	}
+	return val
+}
+
+// Table returns f(0), f(1), ..., f(max), where f(0) is 0
+func (f Multiplicative) Table(max int64) []int64 {
+	return sieveTable(max, f, 1, func(a, b int64) int64 { return a * b })
+}
+
+// Eval returns f(n), for n >= 1, from the factorization of n
+func (f Additive) Eval(n int64) int64 {
+	val := int64(0)
+	for _, pe := range Factorize(n) {
+		val += f(pe.P, pe.E)
+	}
+	return val
+}
+
+// Table returns f(0), f(1), ..., f(max), where f(0) is 0
+func (f Additive) Table(max int64) []int64 {
+	return sieveTable(max, f, 0, func(a, b int64) int64 { return a + b })
+}
+
+// tabulates a function defined on prime powers with a linear sieve: every
+// n is reached once, as its smallest prime p times n/p. With p^e the part
+// of n made of p, f(n) = combine(f(n/p^e), f(p^e)), and both are smaller
+// than n. f(1) = one.
+func sieveTable(max int64, f func(p, e int64) int64, one int64, combine func(a, b int64) int64) []int64 {
+	if max < 0 {
+		return make([]int64, 0)
+	}
+	vals := make([]int64, max+1)
+	if max == 0 {
+		return vals
+	}
+	vals[1] = one
+
+	lp := make([]int64, max+1)    // the smallest prime factor of n
+	lpPow := make([]int64, max+1) // the largest power of lp[n] dividing n
+	lpExp := make([]int64, max+1) // its exponent
+	primes := make([]int64, 0)
+	for i := int64(2); i <= max; i++ {
+		if lp[i] == 0 {
+			lp[i], lpPow[i], lpExp[i] = i, i, 1
+			vals[i] = f(i, 1)
+			primes = append(primes, i)
+		}
+		for _, p := range primes {
+			j := i * p
+			if p > lp[i] || j > max {
+				break
+			}
+			lp[j] = p
+			if p == lp[i] {
+				lpPow[j], lpExp[j] = lpPow[i]*p, lpExp[i]+1
+			} else {
+				lpPow[j], lpExp[j] = p, 1
+			}
+			if rest := j / lpPow[j]; rest == 1 {
+				vals[j] = f(p, lpExp[j])
+			} else {
+				vals[j] = combine(vals[rest], vals[lpPow[j]])
+			}
+		}
+	}
+	return vals
+}
+
+// returns b^e for e >= 0
+func ipow(b, e int64) int64 {
+	r := int64(1)
+	for ; e > 0; e-- {
+		r *= b
+	}
+	return r
+}
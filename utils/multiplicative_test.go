@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestMultiplicative checks that the sieve and factoring agree with each
+// other and with counting by hand
+func TestMultiplicative(t *testing.T) {
+	const max = 3000
+	naive := map[string]func(n int64) int64{
+		"Totient": func(n int64) int64 {
+			count := int64(0)
+			for k := int64(1); k <= n; k++ {
+				if GCD(k, n) == 1 {
+					count++
+				}
+			}
+			return count
+		},
+		"DedekindPsi": func(n int64) int64 {
+			psi := n
+			for _, f := range Factorize(n) {
+				psi = psi / f.P * (f.P + 1)
+			}
+			return psi
+		},
+		"Tau": func(n int64) int64 { return int64(len(Factors(n))) },
+		"Sigma2": func(n int64) int64 {
+			sum := int64(0)
+			for _, d := range Factors(n) {
+				sum += d * d
+			}
+			return sum
+		},
+		"Jordan2": func(n int64) int64 {
+			count := int64(0)
+			for a := int64(1); a <= n; a++ {
+				for b := int64(1); b <= n; b++ {
+					if GCD(GCD(a, b), n) == 1 {
+						count++
+					}
+				}
+			}
+			return count
+		},
+		"Radical": func(n int64) int64 {
+			rad := int64(1)
+			for _, p := range PrimeFactorization(n) {
+				if rad%p != 0 {
+					rad *= p
+				}
+			}
+			return rad
+		},
+		"LargestOddDivisor": func(n int64) int64 {
+			for n%2 == 0 {
+				n /= 2
+			}
+			return n
+		},
+		"Mobius": func(n int64) int64 {
+			mu := int64(1)
+			for _, f := range Factorize(n) {
+				if f.E > 1 {
+					return 0
+				}
+				mu = -mu
+			}
+			return mu
+		},
+		"Liouville": func(n int64) int64 {
+			if len(PrimeFactorization(n))%2 == 1 {
+				return -1
+			}
+			return 1
+		},
+	}
+	funcs := map[string]Multiplicative{
+		"Totient":           Totient,
+		"DedekindPsi":       DedekindPsi,
+		"Tau":               Tau,
+		"Sigma2":            DivisorSigma(2),
+		"Jordan2":           JordanTotient(2),
+		"Radical":           Radical,
+		"LargestOddDivisor": LargestOddDivisor,
+		"Mobius":            Mobius,
+		"Liouville":         Liouville,
+	}
+	for name, f := range funcs {
+		table := f.Table(max)
+		for n := int64(1); n <= max; n++ {
+			if name == "Jordan2" && n > 100 {
+				break // counting pairs by hand is quadratic
+			}
+			want := naive[name](n)
+			if table[n] != want || f.Eval(n) != want {
+				t.Fatalf("%s(%d): table %d, eval %d, want %d", name, n, table[n], f.Eval(n), want)
+			}
+		}
+	}
+}
+
+func TestAdditive(t *testing.T) {
+	omega, bigOmega := Omega.Table(3000), BigOmega.Table(3000)
+	for n := int64(1); n <= 3000; n++ {
+		if want := int64(len(Factorize(n))); omega[n] != want || Omega.Eval(n) != want {
+			t.Fatalf("omega(%d) = %d, want %d", n, omega[n], want)
+		}
+		if want := int64(len(PrimeFactorization(n))); bigOmega[n] != want || BigOmega.Eval(n) != want {
+			t.Fatalf("Omega(%d) = %d, want %d", n, bigOmega[n], want)
+		}
+	}
+}
+
+func TestTableEdges(t *testing.T) {
+	if got := Tau.Table(0); !reflect.DeepEqual(got, []int64{0}) {
+		t.Errorf("Tau.Table(0) = %v", got)
+	}
+	if got := Tau.Table(1); !reflect.DeepEqual(got, []int64{0, 1}) {
+		t.Errorf("Tau.Table(1) = %v", got)
+	}
+	if got := Mobius.Table(10); !reflect.DeepEqual(got, []int64{0, 1, -1, -1, 0, -1, 1, -1, 0, 0, 1}) {
+		t.Errorf("Mobius.Table(10) = %v", got)
+	}
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"testing"
+)
+
+// TestPrimesUpTo checks the sieve against IsPrime across several segments
+func TestPrimesUpTo(t *testing.T) {
+	const bound = 3 * segmentLen
+	primes := PrimesUpTo(bound)
+	i := 0
+	for n := int64(0); n <= bound; n++ {
+		if !IsPrime(n) {
+			continue
+		}
+		if i >= len(primes) {
+			t.Fatalf("the sieve stopped before %d", n)
+		}
+		if primes[i] != n {
+			t.Fatalf("primes[%d] = %d, want %d", i, primes[i], n)
+		}
+		i++
+	}
+	if i != len(primes) {
+		t.Errorf("found %d primes, want %d", len(primes), i)
+	}
+	if got := Primes(int64(len(primes))); got[len(got)-1] != primes[len(primes)-1] {
+		t.Errorf("Primes(%d) ends with %d, want %d", len(primes), got[len(got)-1], primes[len(primes)-1])
+	}
+}
+
+// TestPrimeIterFrom starts the sieve near 10^12, where the segment doesn't
+// start at a multiple of anything
+func TestPrimeIterFrom(t *testing.T) {
+	for _, from := range []int64{0, 2, 3, 4, 1e6 + 1, 1e12 - 100} {
+		it := NewPrimeIter(from)
+		n := from - 1
+		for i := 0; i < 50; i++ {
+			want := NextPrime(n)
+			if got := it.Next(); got != want {
+				t.Fatalf("NewPrimeIter(%d): prime #%d = %d, want %d", from, i, got, want)
+			}
+			n = want
+		}
+	}
+}
+
+func TestNextPrevPrime(t *testing.T) {
+	tests := []struct{ n, next, prev int64 }{
+		{-5, 2, 0},
+		{0, 2, 0},
+		{2, 3, 0},
+		{3, 5, 2},
+		{4, 5, 3},
+		{24, 29, 23},
+		{1e12, 1000000000039, 999999999989},
+		{9223372036854775783, 0, 9223372036854775643}, // the largest int64 prime
+	}
+	for _, tt := range tests {
+		if got := NextPrime(tt.n); got != tt.next {
+			t.Errorf("NextPrime(%d) = %d, want %d", tt.n, got, tt.next)
+		}
+		if got := PrevPrime(tt.n); got != tt.prev {
+			t.Errorf("PrevPrime(%d) = %d, want %d", tt.n, got, tt.prev)
+		}
+	}
+}
+
+// TestPrimePi checks pi(x) against the sieve, and at a few powers of 10
+func TestPrimePi(t *testing.T) {
+	primes := PrimesUpTo(100000)
+	count := int64(0)
+	for x := int64(0); x <= 100000; x++ {
+		if count < int64(len(primes)) && primes[count] == x {
+			count++
+		}
+		if x%997 == 0 || x < 100 {
+			if got := PrimePi(x); got != count {
+				t.Fatalf("PrimePi(%d) = %d, want %d", x, got, count)
+			}
+		}
+	}
+	for x, want := range map[int64]int64{1e9: 50847534, 1e10: 455052511, 1 << 32: 203280221} {
+		if got := PrimePi(x); got != want {
+			t.Errorf("PrimePi(%d) = %d, want %d", x, got, want)
+		}
+	}
+}
+
+func TestNthPrime(t *testing.T) {
+	primes := Primes(20000)
+	for n := int64(1); n <= int64(len(primes)); n += 7 {
+		if got := NthPrime(n); got != primes[n-1] {
+			t.Fatalf("NthPrime(%d) = %d, want %d", n, got, primes[n-1])
+		}
+	}
+	for n, want := range map[int64]int64{0: 0, 1e6: 15485863, 1e8: 2038074743} {
+		if got := NthPrime(n); got != want {
+			t.Errorf("NthPrime(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+// ============================================================================
+// = printer.go
+// = 	Description		Pluggable output formats for the terms of a sequence
+// = 	Note			Add a format with RegisterFormat; no sequence changes needed
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ############################ PRINTERS ###############################
+// ### a Printer receives the terms one at a time, so streamed terms are
+// ### printed as they are found. Formats that need every term (json) buffer.
+
+// Header describes the terms a Printer is about to print
+type Header struct {
+	Seq    string // the OEIS A-number, e.g. "A000045"
+	Offset int64  // the offset of the sequence
+	First  int64  // the index n of the first term that will be printed
+}
+
+// Summary is handed to a Printer after the last term
+type Summary struct {
+	Count       int           // the number of terms printed
+	Duration    time.Duration // how long the terms took to compute
+	Timed       bool          // whether the user asked for the time to be printed
+	Diagnostics []Diagnostic  // everything the sequence emitted
+}
+
+// Printer writes the terms of a sequence in some format
+type Printer interface {
+	Begin(h Header) error
+	Term(n int64, v *bint) error
+	End(s Summary) error
+}
+
+// NewPrinterFunc creates a Printer that writes to w
+type NewPrinterFunc func(w io.Writer) Printer
+
+// every output format, keyed by name
+var formats = map[string]NewPrinterFunc{
+	"table":  func(w io.Writer) Printer { return &tablePrinter{w: w} },
+	"json":   func(w io.Writer) Printer { return &jsonPrinter{w: w} },
+	"csv":    func(w io.Writer) Printer { return &csvPrinter{w: csv.NewWriter(w)} },
+	"ndjson": func(w io.Writer) Printer { return &ndjsonPrinter{enc: json.NewEncoder(w)} },
+	"bfile":  func(w io.Writer) Printer { return &bfilePrinter{w: w} },
+}
+
+// RegisterFormat adds (or replaces) the output format with the given name
+func RegisterFormat(name string, f NewPrinterFunc) {
+	formats[name] = f
+}
+
+// NewPrinter creates a Printer for the named format that writes to w
+func NewPrinter(format string, w io.Writer) (Printer, error) {
+	f, ok := formats[format]
+	if !ok {
+		return nil, errors.New("unknown format " + strconv.Quote(format))
+	}
+	return f(w), nil
+}
+
+// Formats returns the names of every output format, in ascending order
+func Formats() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ### table: the colored n, a(n) table printed by PrintSequence
+
+type tablePrinter struct {
+	w   io.Writer
+	seq string
+}
+
+func (p *tablePrinter) Begin(h Header) error {
+	p.seq = h.Seq
+	if h.Seq != "" {
+		if _, err := fmt.Fprintln(p.w, green+"~~~~~ SEQUENCE "+h.Seq+" ~~~~~"+reset); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(p.w, "n\ta(n)")
+	return err
+}
+
+func (p *tablePrinter) Term(n int64, v *bint) error {
+	_, err := fmt.Fprintf(p.w, "%d\t%d\n", n, v)
+	return err
+}
+
+func (p *tablePrinter) End(s Summary) error {
+	if !s.Timed {
+		return nil
+	}
+	msg := "Computed " + strconv.Itoa(s.Count) + " terms of sequence " + p.seq + " in " + s.Duration.String()
+	_, err := fmt.Fprintln(p.w, green+msg+reset)
+	return err
+}
+
+// ### json: a single object, terms as decimal strings to keep their precision
+
+type jsonPrinter struct {
+	w   io.Writer
+	out struct {
+		ID          string       `json:"id"`
+		Offset      int64        `json:"offset"`
+		First       int64        `json:"first"`
+		Terms       []string     `json:"terms"`
+		Time        string       `json:"time"`
+		TimeNs      int64        `json:"time_ns"`
+		Diagnostics []Diagnostic `json:"diagnostics"`
+	}
+}
+
+func (p *jsonPrinter) Begin(h Header) error {
+	p.out.ID, p.out.Offset, p.out.First = h.Seq, h.Offset, h.First
+	p.out.Terms = make([]string, 0)
+	return nil
+}
+
+func (p *jsonPrinter) Term(n int64, v *bint) error {
+	p.out.Terms = append(p.out.Terms, v.String())
+	return nil
+}
+
+func (p *jsonPrinter) End(s Summary) error {
+	p.out.Time, p.out.TimeNs = s.Duration.String(), s.Duration.Nanoseconds()
+	p.out.Diagnostics = s.Diagnostics
+	if p.out.Diagnostics == nil {
+		p.out.Diagnostics = make([]Diagnostic, 0)
+	}
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p.out)
+}
+
+// ### csv: a header row, then one n,a(n) row per term
+
+type csvPrinter struct {
+	w *csv.Writer
+}
+
+func (p *csvPrinter) Begin(h Header) error {
+	return p.w.Write([]string{"n", "a(n)"})
+}
+
+func (p *csvPrinter) Term(n int64, v *bint) error {
+	if err := p.w.Write([]string{strconv.FormatInt(n, 10), v.String()}); err != nil {
+		return err
+	}
+	p.w.Flush() // streamed terms show up as they are found
+	return p.w.Error()
+}
+
+func (p *csvPrinter) End(s Summary) error {
+	p.w.Flush()
+	return p.w.Error()
+}
+
+// ### ndjson: one object per term, written as soon as the term is found
+
+type ndjsonPrinter struct {
+	enc *json.Encoder
+	seq string
+}
+
+func (p *ndjsonPrinter) Begin(h Header) error {
+	p.seq = h.Seq
+	return nil
+}
+
+func (p *ndjsonPrinter) Term(n int64, v *bint) error {
+	return p.enc.Encode(struct {
+		ID    string `json:"id"`
+		N     int64  `json:"n"`
+		Value string `json:"value"`
+	}{p.seq, n, v.String()})
+}
+
+func (p *ndjsonPrinter) End(s Summary) error { return nil }
+
+// ### bfile: the OEIS b-file format, see bfile.go
+
+type bfilePrinter struct {
+	w io.Writer
+}
+
+func (p *bfilePrinter) Begin(h Header) error { return nil }
+
+func (p *bfilePrinter) Term(n int64, v *bint) error {
+	_, err := fmt.Fprintf(p.w, "%d %d\n", n, v)
+	return err
+}
+
+func (p *bfilePrinter) End(s Summary) error { return nil }
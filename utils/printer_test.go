@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// prints the terms a(first), ... in the given format
+func printAll(t *testing.T, format string, a []*bint, first int64) string {
+	var buf bytes.Buffer
+	p, err := NewPrinter(format, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Begin(Header{Seq: "A000079", Offset: 0, First: first}); err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range a {
+		if err := p.Term(first+int64(i), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	diags := []Diagnostic{{Kind: Accuracy, Seq: "A000079", Message: "m"}}
+	if err := p.End(Summary{Count: len(a), Duration: time.Millisecond, Diagnostics: diags}); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestPrinters(t *testing.T) {
+	a := []*bint{inew(4), pow(inew(2), inew(70))}
+
+	var out struct {
+		ID          string
+		Offset      int64
+		First       int64
+		Terms       []string
+		TimeNs      int64 `json:"time_ns"`
+		Diagnostics []map[string]interface{}
+	}
+	if err := json.Unmarshal([]byte(printAll(t, "json", a, 2)), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.ID != "A000079" || out.First != 2 || len(out.Terms) != 2 || out.Terms[1] != "1180591620717411303424" {
+		t.Errorf("json: got %+v", out)
+	}
+	if out.TimeNs != int64(time.Millisecond) || len(out.Diagnostics) != 1 || out.Diagnostics[0]["kind"] != "accuracy" {
+		t.Errorf("json: got %+v", out)
+	}
+
+	tests := map[string]string{
+		"csv":    "n,a(n)\n2,4\n3,1180591620717411303424\n",
+		"ndjson": `{"id":"A000079","n":2,"value":"4"}` + "\n" + `{"id":"A000079","n":3,"value":"1180591620717411303424"}` + "\n",
+		"bfile":  "2 4\n3 1180591620717411303424\n",
+	}
+	for format, want := range tests {
+		if got := printAll(t, format, a, 2); got != want {
+			t.Errorf("%s: got\n%s\nwant\n%s", format, got, want)
+		}
+	}
+
+	if _, err := NewPrinter("xml", &bytes.Buffer{}); err == nil {
+		t.Error("NewPrinter(xml) succeeded, want an error")
+	}
+}
@@ -0,0 +1,266 @@
+// ============================================================================
+// = recurrence.go
+// = 	Description		Linear recurrences with constant coefficients
+// = 	Note			a(n) is found in O(k^2 log n) steps with Fiduccia's
+// = 					algorithm, and every recurrence has a rational g.f.
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+import (
+	"errors"
+)
+
+// ########################## LINEAR RECURRENCES ############################
+// ### a(n) = c1 a(n-1) + ... + ck a(n-k), plus terms P(n) b^n. Those terms
+// ### satisfy recurrences of their own, so they are folded into the
+// ### coefficients: the recurrence is made homogeneous by multiplying its
+// ### characteristic polynomial by (1 - bx)^(deg P + 1), which also gives
+// ### its g.f. N(x)/D(x).
+
+// LinearRecurrence is a(n) = Coeffs[0] a(n-1) + ... + Coeffs[k-1] a(n-k),
+// plus the Extra terms, for n >= len(Init), starting from a(0), a(1), ... =
+// Init. There must be at least k initial terms.
+type LinearRecurrence struct {
+	Coeffs []int64
+	Init   []int64
+	Extra  []Inhomogeneous
+}
+
+// Inhomogeneous is the term P(n) * Base^(n-Shift) of a recurrence, where
+// P(n) = Poly[0] + Poly[1] n + Poly[2] n^2 + ... . n-Shift must not be
+// negative for any n the recurrence computes.
+type Inhomogeneous struct {
+	Poly  []int64
+	Base  int64
+	Shift int64
+}
+
+// returns P(n) * Base^(n-Shift)
+func (t Inhomogeneous) at(n int64) *bint {
+	p, nk := zero(), inew(1)
+	for _, c := range t.Poly {
+		p.Add(p, mul(inew(c), nk))
+		nk.Mul(nk, inew(n))
+	}
+	return p.Mul(p, pow(inew(t.Base), inew(n-t.Shift)))
+}
+
+// NacciRecurrence returns the k-nacci numbers: each is the sum of the k
+// before it, from k ones, or from 0 and k-1 ones if firstIsZero
+func NacciRecurrence(k int64, firstIsZero bool) LinearRecurrence {
+	r := LinearRecurrence{Coeffs: make([]int64, k), Init: make([]int64, k)}
+	for i := range r.Coeffs {
+		r.Coeffs[i], r.Init[i] = 1, 1
+	}
+	if firstIsZero && k > 0 {
+		r.Init[0] = 0
+	}
+	return r
+}
+
+// returns the recurrence as a homogeneous one, a(n) = Sum_i c[i] a(n-1-i)
+// for n >= len(init)
+func (r LinearRecurrence) homogeneous() (c, init []*bint) {
+	if len(r.Init) < len(r.Coeffs) {
+		panic("utils: a recurrence of order k needs at least k initial terms")
+	}
+
+	// the characteristic polynomial 1 - c1 x - ... - ck x^k, times
+	// (1 - bx)^(deg P + 1) for each extra term
+	d := make([]*bint, len(r.Coeffs)+1)
+	d[0] = inew(1)
+	for i, ci := range r.Coeffs {
+		d[i+1] = inew(-ci)
+	}
+	extra := 0
+	for _, t := range r.Extra {
+		for range t.Poly {
+			d = polyMul(d, []*bint{inew(1), inew(-t.Base)})
+			extra++
+		}
+	}
+	c = make([]*bint, len(d)-1)
+	for i := range c {
+		c[i] = neg(d[i+1])
+	}
+
+	// the homogeneous recurrence needs one more initial term for each
+	// factor, so compute those with the original one
+	init = make([]*bint, len(r.Init)+extra)
+	for n := range init {
+		if n < len(r.Init) {
+			init[n] = inew(r.Init[n])
+			continue
+		}
+		init[n] = zero()
+		for i, ci := range r.Coeffs {
+			init[n].Add(init[n], mul(inew(ci), init[n-1-i]))
+		}
+		for _, t := range r.Extra {
+			init[n].Add(init[n], t.at(int64(n)))
+		}
+	}
+	return c, init
+}
+
+// Terms returns a(0), ..., a(seqlen-1)
+func (r LinearRecurrence) Terms(seqlen int64) []*bint {
+	c, init := r.homogeneous()
+	a := iSlice(seqlen)
+	term := zero()
+	for n := int64(0); n < seqlen; n++ {
+		if n < int64(len(init)) {
+			a[n] = zero().Set(init[n])
+			continue
+		}
+		a[n] = zero()
+		for i, ci := range c {
+			if ci.Sign() != 0 {
+				a[n].Add(a[n], term.Mul(ci, a[n-1-int64(i)]))
+			}
+		}
+	}
+	return a
+}
+
+// Term returns the single term a(n), n >= 0. With Fiduccia's algorithm,
+// a(n) is a combination of k consecutive terms with the coefficients of
+// x^n mod the characteristic polynomial, which takes O(k^2 log n) steps.
+func (r LinearRecurrence) Term(n int64) *bint {
+	c, init := r.homogeneous()
+	if n < int64(len(init)) {
+		return zero().Set(init[n])
+	}
+	k := len(c)
+	if k == 0 {
+		return zero()
+	}
+
+	// b(m) = a(m + s) satisfies the recurrence from b(0) on
+	s := int64(len(init) - k)
+	rem := polyPowMod(n-s, c)
+	val := zero()
+	for i, ri := range rem {
+		val.Add(val, mul(ri, init[s+int64(i)]))
+	}
+	return val
+}
+
+// returns x^e mod x^k - c[0] x^(k-1) - ... - c[k-1], as its k coefficients
+// from the constant one up
+func polyPowMod(e int64, c []*bint) []*bint {
+	k := len(c)
+	mulMod := func(p, q []*bint) []*bint {
+		prod := polyMul(p, q)
+		// x^k = Sum_i c[i] x^(k-1-i), from the top degree down
+		for d := len(prod) - 1; d >= k; d-- {
+			if prod[d].Sign() == 0 {
+				continue
+			}
+			for i, ci := range c {
+				prod[d-1-i].Add(prod[d-1-i], mul(prod[d], ci))
+			}
+		}
+		if len(prod) > k {
+			prod = prod[:k]
+		}
+		for len(prod) < k {
+			prod = append(prod, zero())
+		}
+		return prod
+	}
+
+	result := make([]*bint, k)
+	x := make([]*bint, k)
+	for i := range result {
+		result[i], x[i] = zero(), zero()
+	}
+	result[0].SetInt64(1)
+	if k == 1 {
+		x[0].Set(c[0]) // x = c[0] mod x - c[0]
+	} else {
+		x[1].SetInt64(1)
+	}
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result = mulMod(result, x)
+		}
+		if e > 1 {
+			x = mulMod(x, x)
+		}
+	}
+	return result
+}
+
+// returns the product of two polynomials, constant coefficient first
+func polyMul(p, q []*bint) []*bint {
+	prod := make([]*bint, len(p)+len(q)-1)
+	for i := range prod {
+		prod[i] = zero()
+	}
+	for i, pi := range p {
+		if pi.Sign() == 0 {
+			continue
+		}
+		for j, qj := range q {
+			prod[i+j].Add(prod[i+j], mul(pi, qj))
+		}
+	}
+	return prod
+}
+
+// ############################ GENERATING FUNCTIONS ########################
+
+// GF returns the g.f. of the recurrence as num(x)/den(x), where den is its
+// characteristic polynomial 1 - c1 x - ... and num has a lower degree than
+// the number of initial terms
+func (r LinearRecurrence) GF() (num, den []*bint) {
+	c, init := r.homogeneous()
+	den = make([]*bint, len(c)+1)
+	den[0] = inew(1)
+	for i, ci := range c {
+		den[i+1] = neg(ci)
+	}
+
+	// num = den * A(x), which vanishes from x^len(init) on
+	num = polyMul(den, init)[:len(init)]
+	for len(num) > 1 && num[len(num)-1].Sign() == 0 {
+		num = num[:len(num)-1]
+	}
+	return num, den
+}
+
+// RecurrenceFromGF returns the recurrence whose g.f. is num(x)/den(x). The
+// constant term of den must be 1, so that the terms are integers.
+func RecurrenceFromGF(num, den []int64) (LinearRecurrence, error) {
+	for len(den) > 1 && den[len(den)-1] == 0 {
+		den = den[:len(den)-1]
+	}
+	if len(den) == 0 || den[0] != 1 {
+		return LinearRecurrence{}, errors.New("recurrence: the denominator of the g.f. must have constant term 1")
+	}
+
+	// A(x) den(x) = num(x), so a(n) = num[n] - Sum_i den[i] a(n-i)
+	r := LinearRecurrence{Coeffs: make([]int64, len(den)-1)}
+	for i := range r.Coeffs {
+		r.Coeffs[i] = -den[i+1]
+	}
+	count := len(num)
+	if count < len(r.Coeffs) {
+		count = len(r.Coeffs)
+	}
+	r.Init = make([]int64, count)
+	for n := range r.Init {
+		if n < len(num) {
+			r.Init[n] = num[n]
+		}
+		for i, ci := range r.Coeffs {
+			if n-1-i >= 0 {
+				r.Init[n] += ci * r.Init[n-1-i]
+			}
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"testing"
+)
+
+// TestRecurrenceTerm checks a(n) from Fiduccia's algorithm against the
+// terms generated one at a time
+func TestRecurrenceTerm(t *testing.T) {
+	recs := map[string]LinearRecurrence{
+		"fibonacci":  NacciRecurrence(2, true),
+		"hexanacci":  NacciRecurrence(6, false),
+		"order 1":    {Coeffs: []int64{3}, Init: []int64{2}},
+		"preamble":   {Coeffs: []int64{0, 1}, Init: []int64{7, 1, 2}},
+		"polynomial": {Coeffs: []int64{3}, Init: []int64{1}, Extra: []Inhomogeneous{{Poly: []int64{1, 1}, Base: 1}}},
+		"exponential": {Coeffs: []int64{2, -1, 1}, Init: []int64{0, 1, 4},
+			Extra: []Inhomogeneous{{Poly: []int64{1}, Base: 2, Shift: 1}}},
+	}
+	for name, r := range recs {
+		a := r.Terms(120)
+		for n := int64(0); n < 120; n++ {
+			if got := r.Term(n); got.Cmp(a[n]) != 0 {
+				t.Fatalf("%s: Term(%d) = %v, want %v", name, n, got, a[n])
+			}
+		}
+	}
+
+	fib := NacciRecurrence(2, true)
+	for _, n := range []int64{1000, 12345} {
+		if got := fib.Term(n); got.Cmp(Fibonacci(n)) != 0 {
+			t.Errorf("Term(%d) isn't F(%d)", n, n)
+		}
+	}
+}
+
+// TestRecurrenceExtra checks inhomogeneous recurrences against closed forms
+func TestRecurrenceExtra(t *testing.T) {
+	// a(n) = 3a(n-1) + n + 1, a(0) = 1, is (3^(n+2) - 2n - 5)/4
+	r := LinearRecurrence{Coeffs: []int64{3}, Init: []int64{1}, Extra: []Inhomogeneous{{Poly: []int64{1, 1}, Base: 1}}}
+	for n, v := range r.Terms(30) {
+		want := sub(pow(inew(3), inew(int64(n)+2)), inew(2*int64(n)+5))
+		if want.Quo(want, inew(4)); v.Cmp(want) != 0 {
+			t.Fatalf("a(%d) = %v, want %v", n, v, want)
+		}
+	}
+
+	// a(n) = a(n-1) + n^2 2^n sums k^2 2^k
+	r = LinearRecurrence{Coeffs: []int64{1}, Init: []int64{0}, Extra: []Inhomogeneous{{Poly: []int64{0, 0, 1}, Base: 2}}}
+	sum := zero()
+	for n, v := range r.Terms(30) {
+		sum.Add(sum, mul(inew(int64(n*n)), pow(inew(2), inew(int64(n)))))
+		if v.Cmp(sum) != 0 {
+			t.Fatalf("a(%d) = %v, want %v", n, v, sum)
+		}
+	}
+}
+
+func TestRecurrenceGF(t *testing.T) {
+	num, den := NacciRecurrence(2, true).GF()
+	if !equalInts(num, 0, 1) || !equalInts(den, 1, -1, -1) {
+		t.Errorf("GF of the Fibonacci numbers = %v / %v, want x / (1 - x - x^2)", num, den)
+	}
+
+	// x / ((1-x)^2 (1-x^3)) and back
+	r, err := RecurrenceFromGF([]int64{0, 1}, []int64{1, -2, 1, -1, 2, -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := r.Terms(10); !equalInts(a, 0, 1, 2, 3, 5, 7, 9, 12, 15, 18) {
+		t.Errorf("terms of x/((1-x)^2 (1-x^3)) = %v", a)
+	}
+	num, den = r.GF()
+	if !equalInts(num, 0, 1) || !equalInts(den, 1, -2, 1, -1, 2, -1) {
+		t.Errorf("GF round trip = %v / %v", num, den)
+	}
+
+	if _, err := RecurrenceFromGF([]int64{1}, []int64{2, 1}); err == nil {
+		t.Error("RecurrenceFromGF accepted a denominator with constant term 2")
+	}
+}
+
+func equalInts(a []*bint, want ...int64) bool {
+	if len(a) != len(want) {
+		return false
+	}
+	for i := range a {
+		if a[i].Cmp(inew(want[i])) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,360 @@
+// ============================================================================
+// = series.go
+// = 	Description		Formal power series with exact rational coefficients
+// = 	Note			Every series is truncated: it only knows its first
+// = 					len(s) coefficients, and results keep as many as the
+// = 					operands determine
+// = 	Date			2026.10.17
+// ============================================================================
+
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+// ############################# POWER SERIES ###############################
+// ### a sequence defined by a generating function is read off its power
+// ### series. The coefficients are big.Rats, so nothing is rounded: the
+// ### ordinary g.f. gives a(n) = [x^n] A(x), and the exponential g.f. gives
+// ### a(n) = n! [x^n] A(x).
+
+// Series is the power series s[0] + s[1]x + s[2]x^2 + ..., known up to
+// x^(len(s)-1). The operations never modify their operands.
+type Series []*brat
+
+// NewSeries returns the polynomial coeffs[0] + coeffs[1]x + ..., truncated
+// or padded with zeros to n coefficients
+func NewSeries(n int64, coeffs ...int64) Series {
+	s := zeroSeries(n)
+	for i := int64(0); i < n && i < int64(len(coeffs)); i++ {
+		s[i].SetInt64(coeffs[i])
+	}
+	return s
+}
+
+// Monomial returns c*x^k, to n coefficients
+func Monomial(n int64, c *brat, k int64) Series {
+	s := zeroSeries(n)
+	if k < n {
+		s[k].Set(c)
+	}
+	return s
+}
+
+// returns n zero coefficients
+func zeroSeries(n int64) Series {
+	if n < 0 {
+		n = 0
+	}
+	s := make(Series, n)
+	for i := range s {
+		s[i] = rzero()
+	}
+	return s
+}
+
+// the shorter of two lengths, which is all a result of both can know
+func minLen(a, b Series) int {
+	if len(a) < len(b) {
+		return len(a)
+	}
+	return len(b)
+}
+
+// ############################## ARITHMETIC ################################
+
+// Add returns s + t
+func (s Series) Add(t Series) Series {
+	r := zeroSeries(int64(minLen(s, t)))
+	for i := range r {
+		r[i].Add(s[i], t[i])
+	}
+	return r
+}
+
+// Sub returns s - t
+func (s Series) Sub(t Series) Series {
+	r := zeroSeries(int64(minLen(s, t)))
+	for i := range r {
+		r[i].Sub(s[i], t[i])
+	}
+	return r
+}
+
+// Neg returns -s
+func (s Series) Neg() Series {
+	return s.Scale(rnew(-1, 1))
+}
+
+// Scale returns c*s
+func (s Series) Scale(c *brat) Series {
+	r := zeroSeries(int64(len(s)))
+	for i := range r {
+		r[i].Mul(s[i], c)
+	}
+	return r
+}
+
+// Mul returns s*t
+func (s Series) Mul(t Series) Series {
+	n := minLen(s, t)
+	r := zeroSeries(int64(n))
+	nonzero := s[:n].support() // most series in the OEIS are sparse
+	term := rzero()
+	for k := 0; k < n; k++ {
+		for _, i := range nonzero {
+			if i > k {
+				break
+			}
+			if t[k-i].Sign() != 0 {
+				r[k].Add(r[k], term.Mul(s[i], t[k-i]))
+			}
+		}
+	}
+	return r
+}
+
+// the indices of the nonzero coefficients of s
+func (s Series) support() []int {
+	idx := make([]int, 0)
+	for i, c := range s {
+		if c.Sign() != 0 {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+// Inverse returns 1/s. It panics if s[0] is 0, as big.Rat does when
+// dividing by 0.
+func (s Series) Inverse() Series {
+	if len(s) == 0 {
+		return s
+	}
+	if s[0].Sign() == 0 {
+		panic("utils: inverse of a series with constant term 0")
+	}
+
+	// s * r = 1, so r[0] = 1/s[0] and sum_k s[k] r[n-k] = 0 for n > 0
+	r := zeroSeries(int64(len(s)))
+	inv := rinv(s[0])
+	r[0].Set(inv)
+	nonzero := s.support()[1:]
+	term := rzero()
+	for n := 1; n < len(s); n++ {
+		for _, k := range nonzero {
+			if k > n {
+				break
+			}
+			r[n].Add(r[n], term.Mul(s[k], r[n-k]))
+		}
+		r[n].Mul(r[n], inv)
+		r[n].Neg(r[n])
+	}
+	return r
+}
+
+// Div returns s/t. It panics if t[0] is 0.
+func (s Series) Div(t Series) Series {
+	return s.Mul(t.Inverse())
+}
+
+// Pow returns s^k; for k < 0 that is (1/s)^-k
+func (s Series) Pow(k int64) Series {
+	if k < 0 {
+		return s.Inverse().Pow(-k)
+	}
+	r := NewSeries(int64(len(s)), 1)
+	for b := s; k > 0; k >>= 1 {
+		if k&1 == 1 {
+			r = r.Mul(b)
+		}
+		if k > 1 {
+			b = b.Mul(b)
+		}
+	}
+	return r
+}
+
+// ############################### CALCULUS #################################
+
+// Derivative returns s', which is known to one coefficient fewer
+func (s Series) Derivative() Series {
+	if len(s) == 0 {
+		return s
+	}
+	r := zeroSeries(int64(len(s) - 1))
+	for i := range r {
+		r[i].Mul(s[i+1], rnew(int64(i+1), 1))
+	}
+	return r
+}
+
+// Integral returns the integral of s from 0, which is known to one more
+// coefficient
+func (s Series) Integral() Series {
+	r := zeroSeries(int64(len(s) + 1))
+	for i := range s {
+		r[i+1].Quo(s[i], rnew(int64(i+1), 1))
+	}
+	return r
+}
+
+// Exp returns exp(s). It panics unless s[0] is 0, since exp(s[0]) is
+// irrational otherwise.
+func (s Series) Exp() Series {
+	if len(s) == 0 {
+		return s
+	}
+	if s[0].Sign() != 0 {
+		panic("utils: exp of a series with nonzero constant term")
+	}
+
+	// r = exp(s) has r' = s' r, so n r[n] = sum_k k s[k] r[n-k]
+	r := zeroSeries(int64(len(s)))
+	r[0].SetInt64(1)
+	nonzero := s.support()
+	ks := make(Series, len(s)) // k s[k]
+	for _, k := range nonzero {
+		ks[k] = rmul(rnew(int64(k), 1), s[k])
+	}
+	term := rzero()
+	for n := 1; n < len(s); n++ {
+		for _, k := range nonzero {
+			if k > n {
+				break
+			}
+			r[n].Add(r[n], term.Mul(ks[k], r[n-k]))
+		}
+		r[n].Quo(r[n], rnew(int64(n), 1))
+	}
+	return r
+}
+
+// Log returns log(s). It panics unless s[0] is 1.
+func (s Series) Log() Series {
+	if len(s) == 0 {
+		return s
+	}
+	if s[0].Cmp(rnew(1, 1)) != 0 {
+		panic("utils: log of a series whose constant term isn't 1")
+	}
+	// log(s)' = s'/s
+	return s.Derivative().Div(s[:len(s)-1]).Integral()
+}
+
+// Sqrt returns the square root of s with a positive constant term. It
+// panics unless s[0] is the square of a rational.
+func (s Series) Sqrt() Series {
+	if len(s) == 0 {
+		return s
+	}
+	r := zeroSeries(int64(len(s)))
+	num, den := sqrt(s[0].Num()), sqrt(s[0].Denom())
+	if s[0].Sign() < 0 || mul(num, num).Cmp(s[0].Num()) != 0 || mul(den, den).Cmp(s[0].Denom()) != 0 {
+		panic("utils: sqrt of a series whose constant term isn't a square")
+	}
+	if s[0].Sign() == 0 {
+		panic("utils: sqrt of a series with constant term 0")
+	}
+	r[0].SetFrac(num, den)
+
+	// r^2 = s, so 2 r[0] r[n] = s[n] - sum_{0<k<n} r[k] r[n-k]
+	twice := rmul(rnew(2, 1), r[0])
+	term := rzero()
+	for n := 1; n < len(s); n++ {
+		r[n].Set(s[n])
+		for k := 1; k < n; k++ {
+			if r[k].Sign() != 0 && r[n-k].Sign() != 0 {
+				r[n].Sub(r[n], term.Mul(r[k], r[n-k]))
+			}
+		}
+		r[n].Quo(r[n], twice)
+	}
+	return r
+}
+
+// ############################## COMPOSITION ###############################
+
+// Compose returns s(t). It panics unless t[0] is 0, since every term of s
+// would contribute to the constant term otherwise.
+func (s Series) Compose(t Series) Series {
+	n := minLen(s, t)
+	if n == 0 {
+		return zeroSeries(0)
+	}
+	if t[0].Sign() != 0 {
+		panic("utils: composition with a series whose constant term isn't 0")
+	}
+
+	// Horner's rule: s(t) = s[0] + t(s[1] + t(s[2] + ...))
+	r := zeroSeries(int64(n))
+	for i := n - 1; i >= 0; i-- {
+		if i < n-1 {
+			r = r.Mul(t[:n])
+		}
+		r[0].Add(r[0], s[i])
+	}
+	return r
+}
+
+// Reversion returns the compositional inverse of s: the series r with
+// s(r(x)) = r(s(x)) = x. It panics unless s[0] is 0 and s[1] isn't.
+func (s Series) Reversion() Series {
+	n := len(s)
+	if n < 2 || s[0].Sign() != 0 || s[1].Sign() == 0 {
+		panic("utils: reversion of a series that doesn't start with a multiple of x")
+	}
+
+	// Lagrange inversion: [x^k] r = [x^(k-1)] (x/s)^k / k
+	r := zeroSeries(int64(n))
+	h := s[1:].Inverse() // x/s
+	p := NewSeries(int64(n-1), 1)
+	for k := 1; k < n; k++ {
+		p = p.Mul(h)
+		r[k].Quo(p[k-1], rnew(int64(k), 1))
+	}
+	return r
+}
+
+// ############################# COEFFICIENTS ###############################
+
+// Ordinary returns the coefficients of s, for an ordinary generating
+// function. It fails if one of them isn't an integer.
+func (s Series) Ordinary() ([]*bint, error) {
+	a := iSlice(int64(len(s)))
+	for n, c := range s {
+		if !c.IsInt() {
+			return nil, coeffError(n, c)
+		}
+		a[n] = zero().Set(c.Num())
+	}
+	return a, nil
+}
+
+// Exponential returns n! times the coefficients of s, for an exponential
+// generating function. It fails if one of them isn't an integer.
+func (s Series) Exponential() ([]*bint, error) {
+	a := iSlice(int64(len(s)))
+	nfact := inew(1)
+	c := rzero()
+	for n := range s {
+		if n > 1 {
+			nfact.Mul(nfact, inew(int64(n)))
+		}
+		c.Mul(s[n], c.SetInt(nfact))
+		if !c.IsInt() {
+			return nil, coeffError(n, c)
+		}
+		a[n] = zero().Set(c.Num())
+	}
+	return a, nil
+}
+
+// the error for a g.f. whose nth term isn't an integer
+func coeffError(n int, c *big.Rat) error {
+	return errors.New("series: a(" + strconv.Itoa(n) + ") = " + c.RatString() + " is not an integer")
+}
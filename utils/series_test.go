@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"testing"
+)
+
+// checks the integer coefficients read off s against want
+func checkCoeffs(t *testing.T, name string, s Series, egf bool, want ...int64) {
+	t.Helper()
+	read := s.Ordinary
+	if egf {
+		read = s.Exponential
+	}
+	a, err := read()
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if len(a) != len(want) {
+		t.Fatalf("%s: got %d coefficients, want %d", name, len(a), len(want))
+	}
+	for i := range want {
+		if a[i].Cmp(inew(want[i])) != 0 {
+			t.Fatalf("%s: a(%d) = %v, want %d", name, i, a[i], want[i])
+		}
+	}
+}
+
+func TestSeriesArithmetic(t *testing.T) {
+	const n = 10
+	fib := NewSeries(n, 0, 1).Div(NewSeries(n, 1, -1, -1))
+	checkCoeffs(t, "x/(1-x-x^2)", fib, false, 0, 1, 1, 2, 3, 5, 8, 13, 21, 34)
+	checkCoeffs(t, "(1-x)^-2", NewSeries(n, 1, -1).Pow(-2), false, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	checkCoeffs(t, "(1+x)^3", NewSeries(n, 1, 1).Pow(3), false, 1, 3, 3, 1, 0, 0, 0, 0, 0, 0)
+	checkCoeffs(t, "short operand", NewSeries(n, 1, 1).Add(NewSeries(3, 0, 1, 1)), false, 1, 2, 1)
+	checkCoeffs(t, "s - s", fib.Sub(fib), false, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+}
+
+func TestSeriesTranscendental(t *testing.T) {
+	const n = 10
+	x := Monomial(n, rnew(1, 1), 1)
+
+	// Bell numbers: exp(exp(x) - 1)
+	expm1 := x.Exp().Sub(NewSeries(n, 1))
+	checkCoeffs(t, "exp(exp(x)-1)", expm1.Exp(), true, 1, 1, 2, 5, 15, 52, 203, 877, 4140, 21147)
+	checkCoeffs(t, "compose", x.Exp().Compose(expm1), true, 1, 1, 2, 5, 15, 52, 203, 877, 4140, 21147)
+
+	// log undoes exp, and the derivative undoes the integral
+	checkCoeffs(t, "log(exp(x))", x.Exp().Log(), false, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0)
+	checkCoeffs(t, "(n-1)!", NewSeries(n, 1, -1).Log().Neg(), true, 0, 1, 1, 2, 6, 24, 120, 720, 5040, 40320)
+	checkCoeffs(t, "integral'", x.Exp().Integral().Derivative(), true, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1)
+
+	// Catalan numbers: (1 - sqrt(1-4x))/(2x), and the reversion of x - x^2
+	root := NewSeries(n+1, 1, -4).Sqrt()
+	catalan := NewSeries(n+1, 1).Sub(root)[1:].Scale(rnew(1, 2))
+	checkCoeffs(t, "sqrt(1-4x)", catalan, false, 1, 1, 2, 5, 14, 42, 132, 429, 1430, 4862)
+	checkCoeffs(t, "reversion", NewSeries(n, 0, 1, -1).Reversion(), false, 0, 1, 1, 2, 5, 14, 42, 132, 429, 1430)
+	checkCoeffs(t, "sqrt(4/9)", NewSeries(3, 4).Scale(rnew(1, 9)).Sqrt().Scale(rnew(3, 1)), false, 2, 0, 0)
+}
+
+func TestSeriesNotInteger(t *testing.T) {
+	x := Monomial(4, rnew(1, 1), 1)
+	if _, err := x.Exp().Ordinary(); err == nil {
+		t.Error("the ordinary coefficients of exp(x) aren't integers, but got no error")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("exp(1+x) didn't panic")
+		}
+	}()
+	NewSeries(4, 1, 1).Exp()
+}
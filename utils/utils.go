@@ -7,8 +7,8 @@
 package utils
 
 import (
-	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 )
@@ -34,61 +34,57 @@ func CheckError(err error) {
 	}
 }
 
-// handles an error in a pretty way for the user.
-func HandleError(e error) {
-	if e != nil {
-		PrintError(e.Error())
-		os.Exit(1)
-	}
+// PositiveError is returned when a sequence is asked for a non-positive
+// number of terms
+type PositiveError struct {
+	Seq string // the sequence that was asked
 }
 
-// used to issue an error about a non-positive sequence length
-func PositiveError(seqname string) {
-	msg := "error in sequence " + seqname + ": seqlen must be positive"
-	HandleError(errors.New(msg))
+func (e *PositiveError) Error() string {
+	return "error in sequence " + e.Seq + ": seqlen must be positive"
 }
 
-// used to issue an error about sequence lengths that will cause overflow
-func OverflowError(seqname string, seqlen int64) {
-	slen := strconv.FormatInt(seqlen, 10)
-	msg := "error in sequence " + seqname + ": a seqlen > " + slen + " will result in overflow. Exiting..."
-	HandleError(errors.New(msg))
+// OverflowError is returned when a seqlen larger than Max would overflow
+// the types the sequence computes with
+type OverflowError struct {
+	Seq string // the sequence that was asked
+	Max int64  // the largest seqlen that does not overflow
 }
 
-// used to issue an error about a seqlen that is too small for the sequence
-// say the sequence is Fibonacci, and the user gives a seqlen of 1. hard coding of sequence numbers
-// will cause golang to issue an error when doing a[1] = 1, for instance
-func TooSmallError(seqname string, seqlen int64) {
-	slen := strconv.FormatInt(seqlen, 10)
-	msg := "error in sequence " + seqname + ": a seqlen < " + slen + " is too small. Exiting..."
-	HandleError(errors.New(msg))
+func (e *OverflowError) Error() string {
+	slen := strconv.FormatInt(e.Max, 10)
+	return "error in sequence " + e.Seq + ": a seqlen > " + slen + " will result in overflow"
 }
 
-// ############################ WARNINGS ###########################
-// used to issue a warning about sequence lengths that will take a long time to compute
-// a "long time" is typically more than 5 seconds (which isn't that long, but users are impatient)
-func LongCalculationWarningWithLength(seqname string, seqlen int64) {
-	slen := strconv.FormatInt(seqlen, 10)
-	msg := "Warning: Sequence " + seqname + " with a seqlen > " + slen + " will take time to compute!"
-	PrintWarning(msg)
+// TooSmallError is returned when a seqlen is smaller than the number of terms
+// the sequence hard codes. Say the sequence is Fibonacci, and the user gives a
+// seqlen of 1. Doing a[1] = 1 would then panic with an index out of range.
+type TooSmallError struct {
+	Seq string // the sequence that was asked
+	Min int64  // the smallest seqlen the sequence supports
 }
 
-func LongCalculationWarning(seqname string) {
-	msg := "Warning: Sequence " + seqname + " will take a non-trivial time to compute!"
-	PrintWarning(msg)
+func (e *TooSmallError) Error() string {
+	slen := strconv.FormatInt(e.Min, 10)
+	return "error in sequence " + e.Seq + ": a seqlen < " + slen + " is too small"
 }
 
-// used to issue a warning about a sequence that uses big.Int fields instead of int64
-func BigIntWarning(seqid string, seqlen int64) {
-	slen := strconv.FormatInt(seqlen, 10)
-	msg := "Warning: Sequence " + seqid + " has big.Int fields instead of int64. This *MAY* lead to accuracy issues, especially for seqlen > " + slen + "."
-	PrintWarning(msg)
+// RangeError is returned when terms are requested for indices the sequence
+// does not support (e.g. indices below its offset)
+type RangeError struct {
+	Seq    string // the sequence that was asked
+	From   int64  // the first requested index
+	To     int64  // the last requested index
+	Reason string // why the range is unsupported
 }
 
-// used to issue a warning about the accuracy of a sequence using big.Float fields
-func AccuracyWarning(seqid string) {
-	msg := "Warning: Calculation for sequence " + seqid + " may be inaccurate. This is usually due to limited accuracy of float values which are then rounded."
-	PrintWarning(msg)
+func (e *RangeError) Error() string {
+	from := strconv.FormatInt(e.From, 10)
+	if e.From == e.To {
+		return "error in sequence " + e.Seq + ": index " + from + " is unsupported: " + e.Reason
+	}
+	to := strconv.FormatInt(e.To, 10)
+	return "error in sequence " + e.Seq + ": indices " + from + ".." + to + " are unsupported: " + e.Reason
 }
 
 // ############################ PRINTING FUNCTIONS #########################
@@ -106,34 +102,30 @@ func PrintWarning(msg string) {
 	fmt.Println(yellow + msg + reset)
 }
 
+// prints a warning to w, e.g. os.Stderr, so it stays out of piped output
+func FprintWarning(w io.Writer, msg string) {
+	fmt.Fprintln(w, yellow+msg+reset)
+}
+
 func PrintError(msg string) {
 	fmt.Println(red + msg + reset)
 }
 
-func PrintSequence(seqid string, a []int64, startidx int64) {
-	if a == nil {
-		return
-	}
-	if seqid != "" {
-		PrintInfo("~~~~~ SEQUENCE " + seqid + " ~~~~~")
-	}
-	fmt.Println("n\ta(n)")
-	for i := 0; i < len(a); i++ {
-		fmt.Printf("%d\t%d\n", startidx, a[i])
-		startidx++
-	}
+// prints an error to w, e.g. os.Stderr, so it stays out of piped output
+func FprintError(w io.Writer, msg string) {
+	fmt.Fprintln(w, red+msg+reset)
 }
 
-func PrintBigSequence(seqid string, a []*bint, startidx int64) {
+// prints the terms of a sequence as a table of n and a(n), where the first
+// term is a(startidx)
+func PrintSequence(seqid string, a []*bint, startidx int64) {
 	if a == nil {
 		return
 	}
-	if seqid != "" {
-		PrintInfo("~~~~~ SEQUENCE " + seqid + " ~~~~~")
-	}
-	fmt.Println("n\ta(n)")
+	p := &tablePrinter{w: os.Stdout}
+	p.Begin(Header{Seq: seqid, First: startidx})
 	for i := 0; i < len(a); i++ {
-		fmt.Printf("%d\t%d\n", startidx, a[i])
+		p.Term(startidx, a[i])
 		startidx++
 	}
 }